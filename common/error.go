@@ -1,46 +1,164 @@
 package common
 
-import "net/http"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"runtime"
 
+	"github.com/doyeon0307/tickit-backend/i18n"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ErrorCode는 오류 응답의 errorCode로, 클라이언트가 오류 종류를 구분하는 데 사용합니다.
+// 클라이언트가 값에 의존하므로 한 번 정한 값은 바꾸지 않습니다.
 type ErrorCode string
 
 const (
-	ErrBadRequest   ErrorCode = "BAD_REQUEST"
-	ErrUnauthorized ErrorCode = "UNAUTHORIZIED"
-	ErrNotFound     ErrorCode = "NOT_FOUND"
-	ErrServer       ErrorCode = "SERVER_ERROR"
+	ErrBadRequest    ErrorCode = "BAD_REQUEST"       // 요청을 처리할 수 없는 경우
+	ErrValidation    ErrorCode = "VALIDATION_FAILED" // 요청 항목이 형식이나 규칙에 맞지 않는 경우. details에 항목별 원인이 담깁니다.
+	ErrUnauthorized  ErrorCode = "UNAUTHORIZIED"     // 로그인이 필요하거나 토큰이 유효하지 않은 경우 (기존 클라이언트를 위해 철자를 유지합니다)
+	ErrForbidden     ErrorCode = "FORBIDDEN"         // 다른 사용자의 자원을 사용하려는 경우
+	ErrNotFound      ErrorCode = "NOT_FOUND"
+	ErrConflict      ErrorCode = "CONFLICT"       // 이미 존재하거나 현재 상태에서는 할 수 없는 요청인 경우
+	ErrTooLarge      ErrorCode = "TOO_LARGE"      // 파일 하나가 허용된 크기를 넘은 경우
+	ErrQuotaExceeded ErrorCode = "QUOTA_EXCEEDED" // 사용자의 저장 공간이 부족한 경우
+	ErrRateLimited   ErrorCode = "RATE_LIMITED"   // 짧은 시간에 너무 많이 요청한 경우
+	ErrUnavailable   ErrorCode = "UNAVAILABLE"    // 서버에서 사용할 수 없도록 설정된 기능인 경우
+	ErrServer        ErrorCode = "SERVER_ERROR"
 )
 
 func (e ErrorCode) StatusCode() int {
 	switch e {
-	case ErrBadRequest:
+	case ErrBadRequest, ErrValidation:
 		return http.StatusBadRequest
 	case ErrUnauthorized:
 		return http.StatusUnauthorized
+	case ErrForbidden:
+		return http.StatusForbidden
 	case ErrNotFound:
 		return http.StatusNotFound
+	case ErrConflict:
+		return http.StatusConflict
+	case ErrTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case ErrQuotaExceeded:
+		return http.StatusForbidden
+	case ErrUnavailable:
+		return http.StatusServiceUnavailable
+	case ErrRateLimited:
+		return http.StatusTooManyRequests
 	default:
 		return http.StatusInternalServerError
 	}
 }
 
+// AppError는 사용자에게 보여줄 오류입니다. 핸들러는 c.Error로 넘기기만 하고, 응답은 ErrorMiddleware가 만듭니다.
+// 항상 포인터(*AppError)로 사용합니다.
 type AppError struct {
-	Code    ErrorCode
-	Message string
+	Code ErrorCode
+	// Key는 i18n 메시지 키이며, 응답을 만들 때 요청 언어로 번역됩니다
+	Key string
+	// Args는 메시지의 서식 지정자에 들어갈 값입니다
+	Args []any
+	// Details는 요청 항목별 오류입니다. 주로 ErrValidation과 함께 사용합니다.
+	Details []FieldError
 	Err     error
 }
 
-func (e AppError) Error() string {
+// FieldError는 요청 항목 하나의 오류입니다
+type FieldError struct {
+	// Field는 요청 본문에서 항목의 경로입니다 (fields[0].subtitle)
+	Field string `json:"field"`
+	// Rule은 어긴 규칙입니다 (required, type, format, contrast 등)
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+	// Key와 Args는 Message로 번역할 i18n 메시지입니다
+	Key  string `json:"-"`
+	Args []any  `json:"-"`
+}
+
+// Error는 로그에 남길 수 있도록 기본 언어로 번역한 메시지를 반환합니다
+func (e *AppError) Error() string {
+	message := i18n.Translate(i18n.Default, e.Key, e.Args...)
 	if e.Err != nil {
-		return e.Message + ": " + e.Err.Error()
+		return message + ": " + e.Err.Error()
+	}
+	return message
+}
+
+func (e *AppError) Unwrap() error {
+	return e.Err
+}
+
+func NewError(code ErrorCode, key string, err error) error {
+	return &AppError{
+		Code: code,
+		Key:  key,
+		Err:  err,
+	}
+}
+
+// FieldValidationError는 요청 항목 하나가 규칙에 맞지 않을 때의 오류입니다
+func FieldValidationError(field, rule, key string, args ...any) error {
+	return &AppError{
+		Code:    ErrValidation,
+		Key:     key,
+		Args:    args,
+		Details: []FieldError{{Field: field, Rule: rule, Key: key, Args: args}},
 	}
-	return e.Message
 }
 
-func NewError(code ErrorCode, message string, err error) error {
-	return AppError{
-		Code:    code,
-		Message: message,
-		Err:     err,
+// Localize는 요청 언어로 번역한 메시지와 항목별 오류를 반환합니다
+func (e *AppError) Localize(ctx context.Context) (string, []FieldError) {
+	var details []FieldError
+	if len(e.Details) > 0 {
+		details = make([]FieldError, len(e.Details))
+		for i, detail := range e.Details {
+			if detail.Key != "" {
+				detail.Message = i18n.T(ctx, detail.Key, detail.Args...)
+			}
+			details[i] = detail
+		}
+	}
+	return i18n.T(ctx, e.Key, e.Args...), details
+}
+
+// AsAppError는 err이나 err이 감싼 오류 중 AppError를 찾습니다
+func AsAppError(err error) (*AppError, bool) {
+	var appErr *AppError
+	ok := errors.As(err, &appErr)
+	return appErr, ok
+}
+
+// HasCode는 err이 code의 AppError인지 확인합니다
+func HasCode(err error, code ErrorCode) bool {
+	appErr, ok := AsAppError(err)
+	return ok && appErr.Code == code
+}
+
+// ServerError는 서버 내부 오류를 로그에 남기고 사용자에게 보여줄 AppError로 감쌉니다.
+// 로그에는 오류를 만든 위치와 원래 오류가 함께 기록되며, 진행 중인 스팬은 실패로 표시됩니다.
+func ServerError(ctx context.Context, key string, err error) error {
+	message := i18n.Translate(i18n.Default, key)
+
+	attrs := []any{slog.Any("error", err)}
+	if _, file, line, ok := runtime.Caller(1); ok {
+		attrs = append(attrs, slog.String("caller", fmt.Sprintf("%s:%d", filepath.Base(filepath.Dir(file))+"/"+filepath.Base(file), line)))
+	}
+	slog.ErrorContext(ctx, message, attrs...)
+
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, message)
+
+	return &AppError{
+		Code: ErrServer,
+		Key:  key,
+		Err:  err,
 	}
 }
@@ -1,22 +1,71 @@
 package common
 
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/doyeon0307/tickit-backend/i18n"
+	"github.com/doyeon0307/tickit-backend/logging"
+)
+
 type Response struct {
 	Code    int         `json:"code"`
 	Message string      `json:"message"`
 	Data    interface{} `json:"data,omitempty"`
+	// ErrorCode는 오류 응답에만 담기며, 클라이언트는 언어에 따라 달라지는 메시지 대신 이 값으로 오류를 구분합니다
+	ErrorCode ErrorCode `json:"errorCode,omitempty"`
+	// Details는 요청 항목별 오류입니다 (VALIDATION_FAILED)
+	Details []FieldError `json:"details,omitempty"`
+	// RequestId는 오류 응답에만 담기며, 문의를 받았을 때 서버 로그를 찾는 데 사용합니다
+	RequestId string `json:"requestId,omitempty"`
 }
 
-func Success(code int, message string, data interface{}) Response {
+// Success는 key를 요청 언어로 번역한 메시지와 함께 성공 응답을 만듭니다
+func Success(ctx context.Context, code int, key string, data interface{}) Response {
 	return Response{
 		Code:    code,
-		Message: message,
+		Message: i18n.T(ctx, key),
 		Data:    data,
 	}
 }
 
-func Error(code int, message string) Response {
+func Error(ctx context.Context, err *AppError) Response {
+	message, details := err.Localize(ctx)
 	return Response{
-		Code:    code,
-		Message: message,
+		Code:      err.Code.StatusCode(),
+		Message:   message,
+		ErrorCode: err.Code,
+		Details:   details,
+		RequestId: logging.RequestID(ctx),
+	}
+}
+
+// ProblemDetails는 RFC 7807 형식의 오류 응답입니다. Accept 헤더에 application/problem+json이 있는 요청에 사용합니다.
+type ProblemDetails struct {
+	// Type은 오류 종류를 나타내는 URN입니다 (urn:tickit:error:validation-failed)
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail"`
+	Instance string `json:"instance,omitempty"`
+	// Code는 일반 응답의 errorCode와 같은 값입니다
+	Code      ErrorCode    `json:"code"`
+	Errors    []FieldError `json:"errors,omitempty"`
+	RequestId string       `json:"requestId,omitempty"`
+}
+
+// Problem은 err을 RFC 7807 형식으로 바꿉니다. instance에는 요청 경로를 넘깁니다.
+func Problem(ctx context.Context, err *AppError, instance string) ProblemDetails {
+	message, details := err.Localize(ctx)
+	return ProblemDetails{
+		Type:      "urn:tickit:error:" + strings.ToLower(strings.ReplaceAll(string(err.Code), "_", "-")),
+		Title:     http.StatusText(err.Code.StatusCode()),
+		Status:    err.Code.StatusCode(),
+		Detail:    message,
+		Instance:  instance,
+		Code:      err.Code,
+		Errors:    details,
+		RequestId: logging.RequestID(ctx),
 	}
 }
@@ -0,0 +1,220 @@
+package config
+
+import (
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/models"
+	"github.com/doyeon0307/tickit-backend/tracing"
+)
+
+const (
+	StorageS3    = "s3"
+	StorageLocal = "local"
+
+	OCRTesseract = "tesseract"
+	OCRNone      = "none"
+
+	RateLimitMemory = "memory"
+	RateLimitRedis  = "redis"
+	RateLimitNone   = "none"
+)
+
+// Config는 서버 설정입니다. Load로 기본값, 설정 파일, 환경 변수, 명령행 플래그 순서로 덮어써 만들며,
+// 필요한 값은 main에서 각 생성자에 직접 넘깁니다.
+type Config struct {
+	Server    ServerConfig
+	Log       LogConfig
+	Tracing   TracingConfig
+	Mongo     MongoConfig
+	Auth      AuthConfig
+	Storage   StorageConfig
+	Quota     QuotaConfig
+	ImageGC   ImageGCConfig
+	OCR       OCRConfig
+	Ticket    TicketConfig
+	RateLimit RateLimitConfig
+}
+
+type ServerConfig struct {
+	Port int
+	// ReadHeaderTimeout, ReadTimeout, WriteTimeout, IdleTimeout은 http.Server의 같은 이름 설정입니다.
+	// 휴대폰에서 이미지를 직접 업로드하거나 글자를 인식하는 요청도 끝날 수 있도록 여유 있게 잡습니다.
+	ReadHeaderTimeout time.Duration
+	ReadTimeout       time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	// ShutdownTimeout은 종료 신호를 받은 뒤 처리 중인 요청과 작업을 기다리는 최대 시간입니다
+	ShutdownTimeout time.Duration
+	// TrustedProxies는 X-Forwarded-For를 믿을 프록시의 IP나 CIDR입니다.
+	// 비어 있으면 연결한 주소를 클라이언트 IP로 사용하므로, 헤더를 꾸며 IP별 요청 제한을 피할 수 없습니다.
+	TrustedProxies []string
+	// MetricsAddr는 Prometheus 지표(/metrics)를 제공할 주소입니다. 요청 수와 경로 같은 운영 정보가 드러나므로
+	// 공개 포트가 아닌 별도 리스너로 제공합니다. 컨테이너 밖의 수집기가 접근할 수 있도록 기본값은 모든 인터페이스의 9090 포트이며,
+	// 이 포트는 외부에 공개하지 않아야 합니다. 비어 있으면 제공하지 않습니다.
+	MetricsAddr string
+}
+
+type LogConfig struct {
+	// Level은 기록할 최소 로그 수준입니다 (debug, info, warn, error)
+	Level slog.Level
+}
+
+type TracingConfig struct {
+	// Exporter는 스팬을 내보낼 곳입니다 (otlp, stdout, none)
+	Exporter string
+	// OTLPEndpoint는 OTLP/HTTP 수집기의 host:port입니다. 비어 있으면 OTEL_EXPORTER_OTLP_ENDPOINT를 따릅니다.
+	OTLPEndpoint string
+	// SampleRatio는 새로 시작하는 트레이스 중 기록할 비율입니다 (0~1)
+	SampleRatio float64
+}
+
+type MongoConfig struct {
+	URI            string
+	Database       string
+	ConnectTimeout time.Duration
+	// OperationTimeout은 기한이 없는 컨텍스트로 실행한 조회나 쓰기 하나에 허용하는 시간입니다.
+	// 요청 컨텍스트에 더 짧은 기한이 있으면 그 기한을 따릅니다.
+	OperationTimeout time.Duration
+}
+
+type AuthConfig struct {
+	JWTSecret string
+}
+
+type StorageConfig struct {
+	// Backend는 이미지를 저장할 저장소입니다 (s3, local)
+	Backend string
+	S3      S3Config
+	Local   LocalStorageConfig
+}
+
+type S3Config struct {
+	AccessKey string
+	SecretKey string
+	Region    string
+	Bucket    string
+}
+
+type LocalStorageConfig struct {
+	// Dir은 로컬 저장소가 파일을 저장할 디렉터리입니다
+	Dir string
+	// URL은 로컬 저장소의 서명된 URL에 사용할 서버 주소입니다
+	URL string
+	// SigningKey는 로컬 저장소 URL의 서명 키입니다
+	SigningKey string
+}
+
+// QuotaConfig는 요금제별 저장 공간(MB)입니다
+type QuotaConfig struct {
+	FreeMB    int64
+	PremiumMB int64
+}
+
+type ImageGCConfig struct {
+	// Interval은 미사용 이미지 정리 주기입니다
+	Interval time.Duration
+	// GracePeriod는 업로드 후 정리 대상에서 제외되는 기간입니다
+	GracePeriod time.Duration
+	// DryRun이면 지울 이미지를 집계만 하고 삭제하지 않습니다
+	DryRun bool
+}
+
+type OCRConfig struct {
+	// Engine은 티켓 사진 인식에 사용할 엔진입니다 (tesseract, none)
+	Engine        string
+	TesseractPath string
+	Languages     string
+}
+
+type TicketConfig struct {
+	// MinContrast는 티켓 배경색과 글자색에 요구하는 최소 명도 대비입니다. 0이면 검사하지 않습니다.
+	MinContrast float64
+}
+
+type RateLimitConfig struct {
+	// Store는 요청 제한 버킷을 보관할 곳입니다 (memory, redis, none).
+	// 서버를 여러 대 띄우면 redis를 사용해야 서버 수와 관계없이 같은 한도가 적용됩니다.
+	Store    string
+	RedisURL string
+}
+
+// Default는 설정 파일이나 환경 변수가 없을 때 사용하는 설정입니다
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Port:              7000,
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       60 * time.Second,
+			WriteTimeout:      60 * time.Second,
+			IdleTimeout:       120 * time.Second,
+			ShutdownTimeout:   30 * time.Second,
+			MetricsAddr:       ":9090",
+		},
+		Log: LogConfig{
+			Level: slog.LevelInfo,
+		},
+		Tracing: TracingConfig{
+			Exporter:    tracing.ExporterNone,
+			SampleRatio: 1,
+		},
+		Mongo: MongoConfig{
+			URI:              "mongodb://localhost:27017",
+			Database:         "tickit",
+			ConnectTimeout:   10 * time.Second,
+			OperationTimeout: 5 * time.Second,
+		},
+		Storage: StorageConfig{
+			Backend: StorageS3,
+			S3: S3Config{
+				Region: "us-east-1",
+				Bucket: "tickit-s3-bucket",
+			},
+			Local: LocalStorageConfig{
+				Dir: "./data/storage",
+				URL: "http://localhost:7000",
+			},
+		},
+		Quota: QuotaConfig{
+			FreeMB:    1024,
+			PremiumMB: 20 * 1024,
+		},
+		ImageGC: ImageGCConfig{
+			Interval:    24 * time.Hour,
+			GracePeriod: 72 * time.Hour,
+		},
+		OCR: OCRConfig{
+			Engine:        OCRTesseract,
+			TesseractPath: "tesseract",
+			Languages:     "kor+eng",
+		},
+		RateLimit: RateLimitConfig{
+			Store: RateLimitMemory,
+		},
+	}
+}
+
+// ImageHosts는 저장된 이미지 URL 중 객체 키를 꺼낼 수 있는 호스트입니다.
+// 저장소를 바꾼 뒤에도 기존 URL을 읽을 수 있도록 설정된 버킷과 로컬 저장소 주소를 모두 포함합니다.
+func (c StorageConfig) ImageHosts() []string {
+	var hosts []string
+	if c.S3.Bucket != "" {
+		hosts = append(hosts, c.S3.Bucket+".s3.amazonaws.com")
+		if c.S3.Region != "" {
+			hosts = append(hosts, c.S3.Bucket+".s3."+c.S3.Region+".amazonaws.com")
+		}
+	}
+	if parsed, err := url.Parse(c.Local.URL); err == nil && parsed.Host != "" {
+		hosts = append(hosts, parsed.Host)
+	}
+	return hosts
+}
+
+// StorageQuotas는 요금제별 저장 공간(바이트)입니다
+func (c Config) StorageQuotas() map[models.Plan]int64 {
+	return map[models.Plan]int64{
+		models.PlanFree:    c.Quota.FreeMB << 20,
+		models.PlanPremium: c.Quota.PremiumMB << 20,
+	}
+}
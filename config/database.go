@@ -2,30 +2,56 @@ package config
 
 import (
 	"context"
-	"os"
-	"time"
 
+	"github.com/doyeon0307/tickit-backend/metrics"
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
 )
 
-func ConnectDB() (*mongo.Database, error) {
-	connPattern := os.Getenv("MONGODB_URI")
-	if connPattern == "" {
-		connPattern = "mongodb://localhost:27017"
-	}
-	clientOptions := options.Client().ApplyURI(connPattern)
-	client, err := mongo.NewClient(clientOptions)
+// ConnectDB는 MongoDB에 연결합니다. 종료할 때는 반환된 데이터베이스의 Client().Disconnect를 호출해야 합니다.
+func ConnectDB(cfg MongoConfig) (*mongo.Database, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ConnectTimeout)
+	defer cancel()
+
+	clientOptions := options.Client().
+		ApplyURI(cfg.URI).
+		SetConnectTimeout(cfg.ConnectTimeout).
+		SetServerSelectionTimeout(cfg.ConnectTimeout).
+		SetTimeout(cfg.OperationTimeout).
+		SetMonitor(combineMonitors(metrics.CommandMonitor(), otelmongo.NewMonitor()))
+	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx, _ := context.WithTimeout(context.Background(), time.Duration(5000)*time.Second)
+	return client.Database(cfg.Database), nil
+}
 
-	err = client.Connect(ctx)
-	if err != nil {
-		return nil, err
+// combineMonitors는 클라이언트에 하나만 설정할 수 있는 명령 모니터를 여러 개 함께 쓸 수 있게 합니다
+func combineMonitors(monitors ...*event.CommandMonitor) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			for _, m := range monitors {
+				if m.Started != nil {
+					m.Started(ctx, e)
+				}
+			}
+		},
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			for _, m := range monitors {
+				if m.Succeeded != nil {
+					m.Succeeded(ctx, e)
+				}
+			}
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			for _, m := range monitors {
+				if m.Failed != nil {
+					m.Failed(ctx, e)
+				}
+			}
+		},
 	}
-
-	return client.Database("tickit"), err
 }
@@ -0,0 +1,363 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/tracing"
+	"github.com/joho/godotenv"
+)
+
+// field는 설정 값 하나입니다. key는 환경 변수와 설정 파일에서 쓰는 이름이며,
+// 명령행 플래그는 key를 소문자와 -로 바꾼 이름을 씁니다 (JWT_SECRET_KEY → -jwt-secret-key).
+type field struct {
+	key    string
+	usage  string
+	value  value
+	secret bool
+	// redact는 일부만 가려야 하는 값을 로그에 남길 때 사용합니다
+	redact func(string) string
+}
+
+type value interface {
+	Set(string) error
+	String() string
+}
+
+func (f field) flagName() string {
+	return strings.ToLower(strings.ReplaceAll(f.key, "_", "-"))
+}
+
+// fields는 c의 각 값을 설정 이름과 연결합니다
+func (c *Config) fields() []field {
+	return []field{
+		{key: "PORT", usage: "서버 포트", value: intValue{&c.Server.Port}},
+		{key: "SERVER_READ_HEADER_TIMEOUT", usage: "요청 헤더를 읽는 최대 시간", value: durationValue{&c.Server.ReadHeaderTimeout}},
+		{key: "SERVER_READ_TIMEOUT", usage: "요청 본문까지 읽는 최대 시간", value: durationValue{&c.Server.ReadTimeout}},
+		{key: "SERVER_WRITE_TIMEOUT", usage: "응답을 보내는 최대 시간", value: durationValue{&c.Server.WriteTimeout}},
+		{key: "SERVER_IDLE_TIMEOUT", usage: "keep-alive 연결을 유지하는 최대 시간", value: durationValue{&c.Server.IdleTimeout}},
+		{key: "SHUTDOWN_TIMEOUT", usage: "종료할 때 처리 중인 요청과 작업을 기다리는 최대 시간", value: durationValue{&c.Server.ShutdownTimeout}},
+		{key: "TRUSTED_PROXIES", usage: "X-Forwarded-For를 믿을 프록시 IP나 CIDR (쉼표로 구분)", value: stringListValue{&c.Server.TrustedProxies}},
+		{key: "METRICS_ADDR", usage: "Prometheus 지표를 제공할 내부 주소 (host:port, 비우면 제공하지 않음)", value: stringValue{&c.Server.MetricsAddr}},
+		{key: "LOG_LEVEL", usage: "로그 수준 (debug, info, warn, error)", value: levelValue{&c.Log.Level}},
+		{key: "TRACING_EXPORTER", usage: "트레이스 내보내기 방식 (otlp, stdout, none)", value: stringValue{&c.Tracing.Exporter}},
+		{key: "TRACING_OTLP_ENDPOINT", usage: "OTLP/HTTP 수집기 주소 (host:port)", value: stringValue{&c.Tracing.OTLPEndpoint}},
+		{key: "TRACING_SAMPLE_RATIO", usage: "기록할 트레이스 비율 (0~1)", value: floatValue{&c.Tracing.SampleRatio}},
+		{key: "MONGODB_URI", usage: "MongoDB 연결 문자열", value: stringValue{&c.Mongo.URI}, redact: redactURI},
+		{key: "MONGODB_DATABASE", usage: "MongoDB 데이터베이스 이름", value: stringValue{&c.Mongo.Database}},
+		{key: "MONGODB_CONNECT_TIMEOUT", usage: "MongoDB 연결 제한 시간", value: durationValue{&c.Mongo.ConnectTimeout}},
+		{key: "MONGODB_OPERATION_TIMEOUT", usage: "MongoDB 조회나 쓰기 하나의 제한 시간", value: durationValue{&c.Mongo.OperationTimeout}},
+		{key: "JWT_SECRET_KEY", usage: "JWT 서명 키", value: stringValue{&c.Auth.JWTSecret}, secret: true},
+		{key: "STORAGE_BACKEND", usage: "이미지 저장소 (s3, local)", value: stringValue{&c.Storage.Backend}},
+		{key: "AWS_ACCESS_KEY", usage: "S3 액세스 키", value: stringValue{&c.Storage.S3.AccessKey}, secret: true},
+		{key: "AWS_SECRET_KEY", usage: "S3 시크릿 키", value: stringValue{&c.Storage.S3.SecretKey}, secret: true},
+		{key: "AWS_REGION", usage: "S3 리전", value: stringValue{&c.Storage.S3.Region}},
+		{key: "S3_BUCKET", usage: "S3 버킷", value: stringValue{&c.Storage.S3.Bucket}},
+		{key: "LOCAL_STORAGE_DIR", usage: "로컬 저장소 디렉터리", value: stringValue{&c.Storage.Local.Dir}},
+		{key: "LOCAL_STORAGE_URL", usage: "로컬 저장소 URL에 사용할 서버 주소", value: stringValue{&c.Storage.Local.URL}},
+		{key: "LOCAL_STORAGE_SIGNING_KEY", usage: "로컬 저장소 URL 서명 키", value: stringValue{&c.Storage.Local.SigningKey}, secret: true},
+		{key: "STORAGE_QUOTA_FREE_MB", usage: "무료 요금제 저장 공간(MB)", value: int64Value{&c.Quota.FreeMB}},
+		{key: "STORAGE_QUOTA_PREMIUM_MB", usage: "프리미엄 요금제 저장 공간(MB)", value: int64Value{&c.Quota.PremiumMB}},
+		{key: "IMAGE_GC_INTERVAL", usage: "미사용 이미지 정리 주기", value: durationValue{&c.ImageGC.Interval}},
+		{key: "IMAGE_GC_GRACE_PERIOD", usage: "업로드 후 정리 대상에서 제외되는 기간", value: durationValue{&c.ImageGC.GracePeriod}},
+		{key: "IMAGE_GC_DRY_RUN", usage: "미사용 이미지를 지우지 않고 집계만 하기", value: boolValue{&c.ImageGC.DryRun}},
+		{key: "OCR_ENGINE", usage: "티켓 사진 인식 엔진 (tesseract, none)", value: stringValue{&c.OCR.Engine}},
+		{key: "TESSERACT_PATH", usage: "tesseract 실행 파일 경로", value: stringValue{&c.OCR.TesseractPath}},
+		{key: "OCR_LANGUAGES", usage: "tesseract 언어 데이터", value: stringValue{&c.OCR.Languages}},
+		{key: "RATE_LIMIT_STORE", usage: "요청 제한 저장소 (memory, redis, none)", value: stringValue{&c.RateLimit.Store}},
+		{key: "REDIS_URL", usage: "요청 제한에 사용할 Redis 주소 (redis://host:port/db)", value: stringValue{&c.RateLimit.RedisURL}, redact: redactURI},
+		{key: "TICKET_MIN_CONTRAST", usage: "티켓 색상의 최소 명도 대비 (0이면 검사하지 않음)", value: floatValue{&c.Ticket.MinContrast}},
+	}
+}
+
+// Load는 설정을 읽고 검증합니다. 나중에 읽은 값이 앞의 값을 덮어씁니다.
+//  1. Default의 기본값
+//  2. 설정 파일: 현재 디렉터리의 .env와 -config 플래그나 CONFIG_FILE로 지정한 파일 (KEY=VALUE 형식)
+//  3. 환경 변수
+//  4. 명령행 플래그
+func Load(args []string) (*Config, error) {
+	cfg := Default()
+	fields := cfg.fields()
+
+	flags := flag.NewFlagSet("tickit-backend", flag.ContinueOnError)
+	configFile := flags.String("config", os.Getenv("CONFIG_FILE"), "설정 파일 경로 (KEY=VALUE 형식)")
+	flagValues := make(map[string]string)
+	for _, f := range fields {
+		flags.Var(flagValue{key: f.key, values: flagValues, boolean: isBool(f.value)}, f.flagName(), f.usage)
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	files := []string{}
+	if _, err := os.Stat(".env"); err == nil {
+		files = append(files, ".env")
+	}
+	if *configFile != "" {
+		files = append(files, *configFile)
+	}
+	fileValues := make(map[string]string)
+	for _, file := range files {
+		values, err := godotenv.Read(file)
+		if err != nil {
+			return nil, fmt.Errorf("설정 파일을 읽지 못했습니다(%s): %v", file, err)
+		}
+		for key, value := range values {
+			fileValues[key] = value
+		}
+	}
+
+	var errs []error
+	for _, f := range fields {
+		raw, ok := fileValues[f.key]
+		if env, set := os.LookupEnv(f.key); set && env != "" {
+			raw, ok = env, true
+		}
+		if flagged, set := flagValues[f.key]; set {
+			raw, ok = flagged, true
+		}
+		if !ok {
+			continue
+		}
+		if err := f.value.Set(raw); err != nil {
+			errs = append(errs, fmt.Errorf("%s 값이 올바르지 않습니다: %v", f.key, err))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate는 설정 값이 서버를 시작할 수 있는 값인지 확인합니다
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.Server.Port > 0 && c.Server.Port < 65536, "PORT는 1~65535 사이여야 합니다: %d", c.Server.Port)
+	check(c.Server.ReadHeaderTimeout > 0 && c.Server.ReadTimeout > 0 && c.Server.WriteTimeout > 0 && c.Server.IdleTimeout > 0,
+		"SERVER_*_TIMEOUT은 0보다 커야 합니다")
+	check(c.Server.ShutdownTimeout > 0, "SHUTDOWN_TIMEOUT은 0보다 커야 합니다")
+	for _, proxy := range c.Server.TrustedProxies {
+		_, _, cidrErr := net.ParseCIDR(proxy)
+		check(cidrErr == nil || net.ParseIP(proxy) != nil, "TRUSTED_PROXIES의 값이 IP나 CIDR가 아닙니다: %s", proxy)
+	}
+	if c.Server.MetricsAddr != "" {
+		_, metricsPort, err := net.SplitHostPort(c.Server.MetricsAddr)
+		check(err == nil, "METRICS_ADDR는 host:port 형식이어야 합니다: %s", c.Server.MetricsAddr)
+		// 지표를 공개 포트로 제공하지 않도록 서버 포트와 다른 포트를 사용합니다
+		check(err != nil || metricsPort != strconv.Itoa(c.Server.Port), "METRICS_ADDR는 PORT와 다른 포트를 사용해야 합니다: %s", c.Server.MetricsAddr)
+	}
+	switch c.Tracing.Exporter {
+	case tracing.ExporterOTLP, tracing.ExporterStdout, tracing.ExporterNone:
+	default:
+		check(false, "지원하지 않는 TRACING_EXPORTER입니다: %s", c.Tracing.Exporter)
+	}
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "TRACING_SAMPLE_RATIO는 0~1 사이여야 합니다: %g", c.Tracing.SampleRatio)
+	check(c.Mongo.URI != "", "MONGODB_URI가 필요합니다")
+	check(c.Mongo.Database != "", "MONGODB_DATABASE가 필요합니다")
+	check(c.Mongo.ConnectTimeout > 0, "MONGODB_CONNECT_TIMEOUT은 0보다 커야 합니다")
+	check(c.Mongo.OperationTimeout > 0, "MONGODB_OPERATION_TIMEOUT은 0보다 커야 합니다")
+	check(c.Auth.JWTSecret != "", "JWT_SECRET_KEY가 필요합니다")
+
+	switch c.Storage.Backend {
+	case StorageS3:
+		// 로컬 저장소를 사용할 때는 AWS 키가 필요하지 않습니다
+		check(c.Storage.S3.AccessKey != "" && c.Storage.S3.SecretKey != "", "S3 저장소를 사용하려면 AWS_ACCESS_KEY와 AWS_SECRET_KEY가 필요합니다")
+		check(c.Storage.S3.Region != "", "AWS_REGION이 필요합니다")
+		check(c.Storage.S3.Bucket != "", "S3_BUCKET이 필요합니다")
+	case StorageLocal:
+		check(c.Storage.Local.Dir != "", "LOCAL_STORAGE_DIR이 필요합니다")
+		check(c.Storage.Local.URL != "", "LOCAL_STORAGE_URL이 필요합니다")
+		check(c.Storage.Local.SigningKey != "", "LOCAL_STORAGE_SIGNING_KEY가 필요합니다")
+		// 한쪽 키가 유출되어도 다른 쪽 서명을 만들 수 없도록 JWT 서명 키와 다른 값을 사용합니다
+		check(c.Storage.Local.SigningKey != c.Auth.JWTSecret, "LOCAL_STORAGE_SIGNING_KEY는 JWT_SECRET_KEY와 달라야 합니다")
+	default:
+		check(false, "지원하지 않는 저장소입니다: %s", c.Storage.Backend)
+	}
+
+	check(c.Quota.FreeMB >= 0 && c.Quota.PremiumMB >= 0, "저장 공간은 0MB 이상이어야 합니다")
+	check(c.ImageGC.Interval > 0, "IMAGE_GC_INTERVAL은 0보다 커야 합니다")
+	check(c.ImageGC.GracePeriod > 0, "IMAGE_GC_GRACE_PERIOD는 0보다 커야 합니다")
+	check(c.OCR.Engine == OCRTesseract || c.OCR.Engine == OCRNone, "지원하지 않는 OCR_ENGINE입니다: %s", c.OCR.Engine)
+	switch c.RateLimit.Store {
+	case RateLimitMemory, RateLimitNone:
+	case RateLimitRedis:
+		check(c.RateLimit.RedisURL != "", "Redis 요청 제한 저장소를 사용하려면 REDIS_URL이 필요합니다")
+	default:
+		check(false, "지원하지 않는 RATE_LIMIT_STORE입니다: %s", c.RateLimit.Store)
+	}
+	check(c.Ticket.MinContrast >= 0 && c.Ticket.MinContrast <= 21, "TICKET_MIN_CONTRAST는 0~21 사이여야 합니다: %g", c.Ticket.MinContrast)
+
+	return errors.Join(errs...)
+}
+
+// Redacted는 비밀 값을 가린 설정을 KEY=VALUE 형식으로 반환합니다. 로그에는 이 값만 남깁니다.
+func (c Config) Redacted() string {
+	fields := c.fields()
+	pairs := make([]string, len(fields))
+	for i, f := range fields {
+		v := f.value.String()
+		switch {
+		case f.secret && v != "":
+			v = "****"
+		case f.redact != nil:
+			v = f.redact(v)
+		}
+		pairs[i] = f.key + "=" + v
+	}
+	return strings.Join(pairs, " ")
+}
+
+// String은 실수로 설정을 그대로 출력해도 비밀 값이 남지 않도록 Redacted를 사용합니다
+func (c Config) String() string {
+	return c.Redacted()
+}
+
+// redactURI는 연결 문자열의 비밀번호를 가립니다
+func redactURI(value string) string {
+	u, err := url.Parse(value)
+	if err != nil {
+		return "****"
+	}
+	return u.Redacted()
+}
+
+// flagValue는 명령행 플래그를 바로 설정에 쓰지 않고 모아 두었다가, 설정 파일과 환경 변수를 읽은 뒤에 적용합니다
+type flagValue struct {
+	key     string
+	values  map[string]string
+	boolean bool
+}
+
+func (v flagValue) Set(s string) error {
+	v.values[v.key] = s
+	return nil
+}
+
+func (v flagValue) String() string {
+	return ""
+}
+
+func (v flagValue) IsBoolFlag() bool {
+	return v.boolean
+}
+
+func isBool(v value) bool {
+	_, ok := v.(boolValue)
+	return ok
+}
+
+type stringValue struct{ p *string }
+
+func (v stringValue) Set(s string) error {
+	*v.p = s
+	return nil
+}
+
+func (v stringValue) String() string { return *v.p }
+
+type intValue struct{ p *int }
+
+func (v intValue) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	*v.p = n
+	return nil
+}
+
+func (v intValue) String() string { return strconv.Itoa(*v.p) }
+
+type int64Value struct{ p *int64 }
+
+func (v int64Value) Set(s string) error {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*v.p = n
+	return nil
+}
+
+func (v int64Value) String() string { return strconv.FormatInt(*v.p, 10) }
+
+type floatValue struct{ p *float64 }
+
+func (v floatValue) Set(s string) error {
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	*v.p = n
+	return nil
+}
+
+func (v floatValue) String() string { return strconv.FormatFloat(*v.p, 'g', -1, 64) }
+
+type boolValue struct{ p *bool }
+
+func (v boolValue) Set(s string) error {
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	*v.p = b
+	return nil
+}
+
+func (v boolValue) String() string { return strconv.FormatBool(*v.p) }
+
+type durationValue struct{ p *time.Duration }
+
+func (v durationValue) Set(s string) error {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*v.p = d
+	return nil
+}
+
+func (v durationValue) String() string { return v.p.String() }
+
+type levelValue struct{ p *slog.Level }
+
+func (v levelValue) Set(s string) error {
+	return v.p.UnmarshalText([]byte(s))
+}
+
+func (v levelValue) String() string { return strings.ToLower(v.p.String()) }
+
+// stringListValue는 쉼표로 구분한 목록입니다. 빈 항목은 무시합니다.
+type stringListValue struct{ p *[]string }
+
+func (v stringListValue) Set(s string) error {
+	values := []string{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	*v.p = values
+	return nil
+}
+
+func (v stringListValue) String() string { return strings.Join(*v.p, ",") }
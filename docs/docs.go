@@ -173,6 +173,45 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/auth/locale": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "응답 메시지의 언어를 설정합니다. ko 또는 en을 입력하며, 빈 값을 보내면 설정을 지우고 요청의 Accept-Language를 따릅니다. 설정한 언어는 Accept-Language보다 우선하며, 모든 요청에 반영되기까지 최대 1분이 걸릴 수 있습니다. errorCode는 언어와 관계없이 같습니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "언어 설정하기",
+                "parameters": [
+                    {
+                        "description": "언어",
+                        "name": "locale",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LocaleDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/api/auth/logout": {
             "delete": {
                 "security": [
@@ -247,14 +286,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/s3/presigned-url": {
-            "get": {
+        "/api/auth/timezone": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Presigend URL를 얻고, 해당 URL을 통해 S3 이미지 업로드를 수행합니다",
+                "description": "티켓과 일정의 날짜·시간을 해석할 기본 시간대를 설정합니다. Asia/Seoul과 같은 IANA 시간대 이름을 입력합니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -262,9 +301,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "S3"
+                    "Auth"
+                ],
+                "summary": "시간대 설정하기",
+                "parameters": [
+                    {
+                        "description": "시간대",
+                        "name": "timeZone",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TimeZoneDTO"
+                        }
+                    }
                 ],
-                "summary": "Presigend URL 불러오기",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -275,14 +325,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/schedules": {
+        "/api/auth/usage": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "시작 날짜와 종료 날짜 사이의 일정 목록을 불러옵니다",
+                "description": "요금제별 저장 공간과 업로드를 확인한 이미지의 사용량을 가져옵니다. 저장 공간이 부족하면 업로드 URL 발급과 업로드 확인이 403(QUOTA_EXCEEDED)으로 거부됩니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -290,25 +340,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Schedules"
-                ],
-                "summary": "달력에 일정 목록 불러오기",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "시작 날짜",
-                        "name": "startDate",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "종료 날짜",
-                        "name": "endDate",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Auth"
                 ],
+                "summary": "저장 공간 사용량 가져오기",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -321,7 +355,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.ScheduleCalendarPreviewDTO"
+                                            "$ref": "#/definitions/dto.StorageUsageDTO"
                                         }
                                     }
                                 }
@@ -329,33 +363,60 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/health": {
+            "get": {
+                "description": "/readyz와 같은 준비 상태를 반환합니다. 이전 클라이언트를 위해 남겨 둔 경로이므로 /livez 또는 /readyz를 사용해주세요.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "상태 확인하기",
+                "deprecated": true,
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.Report"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/health.Report"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/images": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "일정을 생성합니다. presigned-url을 발급받아 이미지 업로드를 완료한 후에, s3 url을 image 값으로 저장합니다. 날짜 형식은 YYYY-MM-DD, 시간 형식은 AM/PM-HH-MM입니다.",
+                "description": "Presigned URL 대신 multipart/form-data의 image 필드로 이미지를 서버에 직접 업로드합니다. 파일 내용으로 형식을 판별하며, 업로드 확인까지 함께 처리되므로 /api/s3/confirm을 호출하지 않아도 됩니다. 응답의 key는 Presigned URL 업로드와 같은 형식입니다. 10MB를 넘는 이미지는 413(TOO_LARGE), 저장 공간이 부족하면 403(QUOTA_EXCEEDED)으로 거부됩니다.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Schedules"
+                    "S3"
                 ],
-                "summary": "일정 생성하기",
+                "summary": "이미지 직접 업로드하기",
                 "parameters": [
                     {
-                        "description": "일정 DTO",
-                        "name": "scheduleDTO",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ScheduleDTO"
-                        }
+                        "type": "file",
+                        "description": "이미지 파일 (JPEG, PNG, WebP, HEIC, 최대 10MB)",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -370,7 +431,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.ScheduleResponseDTO"
+                                            "$ref": "#/definitions/dto.UploadDTO"
                                         }
                                     }
                                 }
@@ -380,14 +441,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/schedules/for-ticket": {
+        "/api/images/colors": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "현 날짜 이전의 일정 목록을 불러옵니다. 티켓 생성 화면의'일정 불러오기' 버튼에서 사용됩니다.",
+                "description": "업로드한 이미지에서 많이 쓰인 색을 뽑고, 가장 많이 쓰인 색을 배경색으로 하여 WCAG AA 명도 대비(4.5:1 이상)를 만족하는 글자색을 추천합니다. 색상은 0xAARRGGBB 형식입니다. HEIC 이미지는 분석할 수 없습니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -395,15 +456,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Schedules"
+                    "S3"
                 ],
-                "summary": "티켓 생성 가능한 일정 목록 불러오기",
+                "summary": "이미지로 티켓 색상 추천받기",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "오늘 날짜",
-                        "name": "date",
-                        "in": "query"
+                        "description": "업로드한 이미지 키",
+                        "name": "key",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -418,7 +480,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.ScheduleTicketPreviewDTO"
+                                            "$ref": "#/definitions/dto.ColorSuggestionDTO"
                                         }
                                     }
                                 }
@@ -428,14 +490,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/schedules/{id}": {
-            "get": {
+        "/api/s3/confirm": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "세부 일정을 불러옵니다",
+                "description": "Presigned URL로 업로드한 이미지가 존재하고 실제 이미지 파일인지 확인합니다. 이미지가 아니면 업로드된 파일은 삭제됩니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -443,16 +505,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Schedules"
+                    "S3"
                 ],
-                "summary": "세부 일정 불러오기",
+                "summary": "업로드 확인하기",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "일정 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "업로드한 이미지 키",
+                        "name": "uploadConfirmDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UploadConfirmDTO"
+                        }
                     }
                 ],
                 "responses": {
@@ -467,7 +531,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.ScheduleResponseDTO"
+                                            "$ref": "#/definitions/dto.UploadDTO"
                                         }
                                     }
                                 }
@@ -475,14 +539,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/s3/presigned-url": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "일정을 수정합니다. presigned-url을 발급받아 이미지 업로드를 완료한 후에, s3 url을 image 값으로 저장합니다.",
+                "description": "Presigend URL를 얻고, 해당 URL을 통해 S3 이미지 업로드를 수행합니다. 업로드 시 contentType과 size에 맞는 Content-Type, Content-Length 헤더를 보내야 합니다. 업로드 후에는 /api/s3/confirm으로 업로드를 확인합니다. 10MB를 넘는 이미지는 413(TOO_LARGE), 저장 공간이 부족하면 403(QUOTA_EXCEEDED)으로 거부됩니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -490,25 +556,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Schedules"
+                    "S3"
                 ],
-                "summary": "일정 수정하기",
+                "summary": "Presigend URL 불러오기",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "일정 ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "이미지 Content-Type (image/jpeg, image/png, image/webp, image/heic, image/heif)",
+                        "name": "contentType",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "일정 DTO",
-                        "name": "scheduleDTO",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ScheduleDTO"
-                        }
+                        "type": "integer",
+                        "description": "이미지 크기 (바이트, 최대 10MB)",
+                        "name": "size",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -523,7 +587,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.ScheduleResponseDTO"
+                                            "$ref": "#/definitions/dto.S3UrlDTO"
                                         }
                                     }
                                 }
@@ -531,14 +595,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/schedules": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "일정을 삭제합니다",
+                "description": "시작 날짜와 종료 날짜 사이의 일정 목록을 불러옵니다. image는 1시간 동안 유효한 서명된 URL이며, 저장된 키는 imageKey로 내려갑니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -548,44 +614,29 @@ const docTemplate = `{
                 "tags": [
                     "Schedules"
                 ],
-                "summary": "일정 삭제하기",
+                "summary": "달력에 일정 목록 불러오기",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "일정 ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "시작 날짜",
+                        "name": "startDate",
+                        "in": "query",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/common.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/tickets": {
-            "get": {
-                "security": [
+                    },
                     {
-                        "ApiKeyAuth": []
+                        "type": "string",
+                        "description": "종료 날짜",
+                        "name": "endDate",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "일정 상태 (PLANNED, BOOKED, ATTENDED, CANCELLED, REFUNDED), 콤마로 구분",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
-                "description": "홈 화면에 작성한 티켓 목록을 불러옵니다",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Tickets"
-                ],
-                "summary": "티켓 목록 불러오기",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -598,7 +649,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.TicketPreview"
+                                            "$ref": "#/definitions/dto.ScheduleCalendarPreviewDTO"
                                         }
                                     }
                                 }
@@ -613,7 +664,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "티켓을 생성합니다. presigned-url을 발급받아 이미지 업로드를 완료한 후에, s3 url을 image 값으로 저장합니다. 날짜 형식은 YYYY-MM-DD, 시간 형식은 AM/PM-HH-MM입니다.",
+                "description": "일정을 생성합니다. presigned-url을 발급받아 이미지 업로드와 확인(/api/s3/confirm)을 완료한 후에, 발급받은 key를 image 값으로 저장합니다. 여러 장을 등록할 때는 images에 순서대로 담고, image 또는 cover로 대표 사진을 지정합니다. 본인이 업로드한 이미지만 사용할 수 있습니다. 날짜 형식은 YYYY-MM-DD, 시간 형식은 AM/PM-HH-MM입니다. 종료 시간(endTime) 또는 소요 시간(duration, 분)을 입력할 수 있으며, 다른 일정과 시간이 겹치면 warnings에 해당 일정이 포함됩니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -621,17 +672,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tickets"
+                    "Schedules"
                 ],
-                "summary": "티켓 생성하기",
+                "summary": "일정 생성하기",
                 "parameters": [
                     {
-                        "description": "생성할 티켓 DTO",
-                        "name": "ticketDTO",
+                        "description": "일정 DTO",
+                        "name": "scheduleDTO",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.TicketDTO"
+                            "$ref": "#/definitions/dto.ScheduleDTO"
                         }
                     }
                 ],
@@ -647,7 +698,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.TicketDTO"
+                                            "$ref": "#/definitions/dto.ScheduleResponseDTO"
                                         }
                                     }
                                 }
@@ -657,14 +708,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/tickets/{id}": {
+        "/api/schedules/conflicts": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "티켓 아이디로 세부정보를 불러옵니다",
+                "description": "아직 끝나지 않은 일정 중 시간이 겹치는 일정 목록을 불러옵니다. 취소되거나 환불된 일정은 제외됩니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -672,18 +723,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tickets"
-                ],
-                "summary": "티켓 세부정보 불러오기",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "티켓 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Schedules"
                 ],
+                "summary": "겹치는 일정 목록 불러오기",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -696,7 +738,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.TicketDTO"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.ScheduleConflictDTO"
+                                            }
                                         }
                                     }
                                 }
@@ -704,14 +749,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/schedules/for-ticket": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "티켓을 수정합니다. presigned-url을 발급받아 이미지 업로드를 완료한 후에, s3 url을 image 값으로 저장합니다.",
+                "description": "현 날짜 이전의 일정 중 아직 티켓으로 만들지 않은 일정 목록을 불러옵니다. 상태를 지정하지 않으면 ATTENDED 일정만 불러옵니다. 티켓 생성 화면의'일정 불러오기' 버튼에서 사용됩니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -719,25 +766,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tickets"
+                    "Schedules"
                 ],
-                "summary": "티켓 수정하기",
+                "summary": "티켓 생성 가능한 일정 목록 불러오기",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "티켓 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "오늘 날짜 (기본값: 사용자 시간대 기준 오늘)",
+                        "name": "date",
+                        "in": "query"
                     },
                     {
-                        "description": "수정된 티켓 DTO",
-                        "name": "ticketDTO",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.TicketUpdateDTO"
-                        }
+                        "type": "string",
+                        "description": "일정 상태 (PLANNED, BOOKED, ATTENDED, CANCELLED, REFUNDED), 콤마로 구분",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -752,7 +795,155 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.TicketDTO"
+                                            "$ref": "#/definitions/dto.ScheduleTicketPreviewDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/schedules/lotteries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "추첨·취소표 대기 응모 중 결과가 나오지 않은 목록을 결과 발표일 순으로 불러옵니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "결과 대기 중인 응모 목록 불러오기",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.LotteryPreviewDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/schedules/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "세부 일정을 불러옵니다. image는 대표 사진의 1시간 동안 유효한 서명된 URL이며, 저장된 키는 imageKey로 내려갑니다. images에는 모든 사진이 순서대로 담깁니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "세부 일정 불러오기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "일정 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ScheduleResponseDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "일정을 수정합니다. image는 대표 사진을 교체하며, 사진 목록은 /api/schedules/{id}/images 경로로 수정합니다(images는 무시됩니다). 본인이 업로드한 이미지만 사용할 수 있습니다. 다른 일정과 시간이 겹치면 warnings에 해당 일정이 포함됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "일정 수정하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "일정 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "일정 DTO",
+                        "name": "scheduleDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScheduleDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ScheduleResponseDTO"
                                         }
                                     }
                                 }
@@ -767,7 +958,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "티켓을 삭제합니다",
+                "description": "일정을 삭제합니다",
                 "consumes": [
                     "application/json"
                 ],
@@ -775,13 +966,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tickets"
+                    "Schedules"
                 ],
-                "summary": "티켓 삭제하기",
+                "summary": "일정 삭제하기",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "티켓 ID",
+                        "description": "일정 ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -796,78 +987,1463 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "common.Response": {
+        },
+        "/api/schedules/{id}/images": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "일정에 사진을 추가합니다. 업로드 확인(/api/s3/confirm)을 마친 key를 사용하며, 사진은 10장까지 등록할 수 있습니다. cover가 true이거나 첫 사진이면 대표 사진이 됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "일정 사진 추가하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "일정 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "추가할 사진",
+                        "name": "imageInputDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImageInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.ImageDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/schedules/{id}/images/order": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "사진을 imageIds 순서로 정렬합니다. 모든 사진의 ID를 한 번씩 보내야 합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "일정 사진 순서 변경하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "일정 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "사진 ID 순서",
+                        "name": "imageOrderDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImageOrderDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.ImageDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/schedules/{id}/images/{imageId}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "사진을 삭제합니다. 대표 사진을 삭제하면 남은 사진 중 첫 번째 사진이 대표 사진이 됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "일정 사진 삭제하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "일정 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "사진 ID",
+                        "name": "imageId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.ImageDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "사진 설명을 수정하거나 대표 사진으로 지정합니다. caption을 보내지 않으면 설명은 그대로 유지됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "일정 사진 수정하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "일정 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "사진 ID",
+                        "name": "imageId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "수정할 내용",
+                        "name": "imageUpdateDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImageUpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.ImageDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/schedules/{id}/lottery": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "PLANNED 상태의 일정에 추첨(LOTTERY) 또는 취소표 대기(WAITLIST) 응모를 등록합니다. 결과 발표일 형식은 YYYY-MM-DD입니다. 결과가 나온 응모는 새 응모로 바뀌지만, 결과를 기다리는 응모가 있으면 409를 반환합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "응모 등록하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "일정 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "응모 정보",
+                        "name": "lotteryEntryDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LotteryEntryDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ScheduleResponseDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "응모 결과(WON, LOST)를 입력합니다. 당첨(WON)되면 일정이 BOOKED 상태로 바뀝니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "응모 결과 입력하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "일정 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "응모 결과",
+                        "name": "lotteryResultDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LotteryResultDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ScheduleResponseDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/schedules/{id}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "일정 상태를 변경합니다. PLANNED → BOOKED/CANCELLED, BOOKED → ATTENDED/CANCELLED/REFUNDED, CANCELLED → REFUNDED 순서로만 변경할 수 있습니다. 시작 시간이 지난 PLANNED 일정은 ATTENDED로 바로 변경할 수 있습니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "일정 상태 변경하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "일정 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "변경할 상태",
+                        "name": "statusDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScheduleStatusDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ScheduleResponseDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tickets": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "홈 화면에 작성한 티켓 목록을 불러옵니다. image는 대표 사진의 1시간 동안 유효한 서명된 URL이며, 저장된 키는 imageKey로 내려갑니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tickets"
+                ],
+                "summary": "티켓 목록 불러오기",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.TicketPreview"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "티켓을 생성합니다. presigned-url을 발급받아 이미지 업로드와 확인(/api/s3/confirm)을 완료한 후에, 발급받은 key를 image 값으로 저장합니다. 여러 장을 등록할 때는 images에 순서대로 담고, image 또는 cover로 대표 사진을 지정합니다. 본인이 업로드한 이미지만 사용할 수 있습니다. 날짜 형식은 YYYY-MM-DD, 시간 형식은 AM/PM-HH-MM입니다. backgroundColor와 foregroundColor는 0xAARRGGBB 또는 #RRGGBB 형식이며, 서버 설정에 따라 명도 대비가 낮은 조합은 거부됩니다. 추천 색상은 /api/images/colors에서 받을 수 있습니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tickets"
+                ],
+                "summary": "티켓 생성하기",
+                "parameters": [
+                    {
+                        "description": "생성할 티켓 DTO",
+                        "name": "ticketDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TicketDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.TicketDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tickets/draft-from-image": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "업로드한 종이 티켓 사진에서 글자를 인식해 제목, 장소, 날짜, 시간, 좌석을 채운 티켓 초안을 만듭니다. 티켓은 저장되지 않으며, 사용자가 확인·수정한 뒤 티켓 생성하기로 저장합니다. 좌석은 fields에 요청 언어로 된 \"좌석\"(Seat) 항목으로 담기고, confidence에는 항목별 인식 신뢰도(0~1, 찾지 못하면 0)가 담깁니다. 서버에서 글자 인식을 사용할 수 없으면 503(UNAVAILABLE)을 반환합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tickets"
+                ],
+                "summary": "사진으로 티켓 초안 만들기",
+                "parameters": [
+                    {
+                        "description": "업로드한 티켓 사진 키",
+                        "name": "ticketDraftRequestDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TicketDraftRequestDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.TicketDraftDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tickets/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "티켓 아이디로 세부정보를 불러옵니다. image는 대표 사진의 1시간 동안 유효한 서명된 URL이며, 저장된 키는 imageKey로 내려갑니다. images에는 모든 사진이 순서대로 담깁니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tickets"
+                ],
+                "summary": "티켓 세부정보 불러오기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "티켓 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.TicketDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "티켓을 수정합니다. image는 대표 사진을 교체하며, 사진 목록은 /api/tickets/{id}/images 경로로 수정합니다. 본인이 업로드한 이미지만 사용할 수 있습니다. 색상 형식과 명도 대비는 생성할 때와 같이 검사합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tickets"
+                ],
+                "summary": "티켓 수정하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "티켓 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "수정된 티켓 DTO",
+                        "name": "ticketDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TicketUpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.TicketDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "티켓을 삭제합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tickets"
+                ],
+                "summary": "티켓 삭제하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "티켓 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tickets/{id}/images": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "티켓에 사진을 추가합니다. 업로드 확인(/api/s3/confirm)을 마친 key를 사용하며, 사진은 10장까지 등록할 수 있습니다. cover가 true이거나 첫 사진이면 대표 사진이 됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tickets"
+                ],
+                "summary": "티켓 사진 추가하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "티켓 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "추가할 사진",
+                        "name": "imageInputDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImageInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.ImageDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tickets/{id}/images/order": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "사진을 imageIds 순서로 정렬합니다. 모든 사진의 ID를 한 번씩 보내야 합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tickets"
+                ],
+                "summary": "티켓 사진 순서 변경하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "티켓 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "사진 ID 순서",
+                        "name": "imageOrderDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImageOrderDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.ImageDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tickets/{id}/images/{imageId}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "사진을 삭제합니다. 대표 사진을 삭제하면 남은 사진 중 첫 번째 사진이 대표 사진이 됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tickets"
+                ],
+                "summary": "티켓 사진 삭제하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "티켓 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "사진 ID",
+                        "name": "imageId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.ImageDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "사진 설명을 수정하거나 대표 사진으로 지정합니다. caption을 보내지 않으면 설명은 그대로 유지됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tickets"
+                ],
+                "summary": "티켓 사진 수정하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "티켓 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "사진 ID",
+                        "name": "imageId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "수정할 내용",
+                        "name": "imageUpdateDTO",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImageUpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/common.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.ImageDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/livez": {
+            "get": {
+                "description": "서버 프로세스가 요청에 응답할 수 있는지 확인합니다. 의존하는 서비스는 확인하지 않으므로, 실패하면 서버를 재시작해야 합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "실행 상태 확인하기",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "MongoDB와 이미지 저장소 등 의존하는 서비스를 확인합니다. 하나라도 실패하면 503을 반환하며, checks에 서비스별 상태와 응답 시간이 담깁니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "준비 상태 확인하기",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.Report"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/health.Report"
+                        }
+                    }
+                }
+            }
+        },
+        "/storage/{key}": {
+            "get": {
+                "description": "로컬 저장소 사용 시 이미지 응답에 포함되는 URL입니다.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Storage"
+                ],
+                "summary": "로컬 저장소에서 내려받기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "객체 키",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "만료 시각 (Unix)",
+                        "name": "expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "서명",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "로컬 저장소 사용 시 /api/s3/presigned-url이 발급한 URL입니다. 발급 시 지정한 Content-Type, Content-Length 헤더와 함께 이미지를 보냅니다.",
+                "consumes": [
+                    "application/octet-stream"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Storage"
+                ],
+                "summary": "로컬 저장소에 업로드하기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "객체 키",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "만료 시각 (Unix)",
+                        "name": "expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Content-Type",
+                        "name": "contentType",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "크기 (바이트)",
+                        "name": "size",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "서명",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "common.ErrorCode": {
+            "type": "string",
+            "enum": [
+                "BAD_REQUEST",
+                "VALIDATION_FAILED",
+                "UNAUTHORIZIED",
+                "FORBIDDEN",
+                "NOT_FOUND",
+                "CONFLICT",
+                "TOO_LARGE",
+                "QUOTA_EXCEEDED",
+                "RATE_LIMITED",
+                "UNAVAILABLE",
+                "SERVER_ERROR"
+            ],
+            "x-enum-comments": {
+                "ErrBadRequest": "요청을 처리할 수 없는 경우",
+                "ErrConflict": "이미 존재하거나 현재 상태에서는 할 수 없는 요청인 경우",
+                "ErrForbidden": "다른 사용자의 자원을 사용하려는 경우",
+                "ErrQuotaExceeded": "사용자의 저장 공간이 부족한 경우",
+                "ErrRateLimited": "짧은 시간에 너무 많이 요청한 경우",
+                "ErrTooLarge": "파일 하나가 허용된 크기를 넘은 경우",
+                "ErrUnauthorized": "로그인이 필요하거나 토큰이 유효하지 않은 경우 (기존 클라이언트를 위해 철자를 유지합니다)",
+                "ErrUnavailable": "서버에서 사용할 수 없도록 설정된 기능인 경우",
+                "ErrValidation": "요청 항목이 형식이나 규칙에 맞지 않는 경우. details에 항목별 원인이 담깁니다."
+            },
+            "x-enum-varnames": [
+                "ErrBadRequest",
+                "ErrValidation",
+                "ErrUnauthorized",
+                "ErrForbidden",
+                "ErrNotFound",
+                "ErrConflict",
+                "ErrTooLarge",
+                "ErrQuotaExceeded",
+                "ErrRateLimited",
+                "ErrUnavailable",
+                "ErrServer"
+            ]
+        },
+        "common.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "description": "Field는 요청 본문에서 항목의 경로입니다 (fields[0].subtitle)",
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "rule": {
+                    "description": "Rule은 어긴 규칙입니다 (required, type, format, contrast 등)",
+                    "type": "string"
+                }
+            }
+        },
+        "common.Response": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {},
+                "details": {
+                    "description": "Details는 요청 항목별 오류입니다 (VALIDATION_FAILED)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.FieldError"
+                    }
+                },
+                "errorCode": {
+                    "description": "ErrorCode는 오류 응답에만 담기며, 클라이언트는 언어에 따라 달라지는 메시지 대신 이 값으로 오류를 구분합니다",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/common.ErrorCode"
+                        }
+                    ]
+                },
+                "message": {
+                    "type": "string"
+                },
+                "requestId": {
+                    "description": "RequestId는 오류 응답에만 담기며, 문의를 받았을 때 서버 로그를 찾는 데 사용합니다",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ColorSuggestionDTO": {
+            "type": "object",
+            "properties": {
+                "backgroundColor": {
+                    "type": "string",
+                    "example": "0xFF1E3A5F"
+                },
+                "contrastRatio": {
+                    "type": "number",
+                    "example": 11.7
+                },
+                "foregroundColor": {
+                    "type": "string",
+                    "example": "0xFFFFFFFF"
+                },
+                "palette": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PaletteColorDTO"
+                    }
+                }
+            }
+        },
+        "dto.Field": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "subtitle": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ImageDTO": {
+            "type": "object",
+            "properties": {
+                "caption": {
+                    "type": "string"
+                },
+                "cover": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "imageKey": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ImageInputDTO": {
+            "type": "object",
+            "required": [
+                "key"
+            ],
+            "properties": {
+                "caption": {
+                    "type": "string"
+                },
+                "cover": {
+                    "type": "boolean"
+                },
+                "key": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ImageOrderDTO": {
+            "type": "object",
+            "required": [
+                "imageIds"
+            ],
+            "properties": {
+                "imageIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.ImageUpdateDTO": {
+            "type": "object",
+            "properties": {
+                "caption": {
+                    "type": "string"
+                },
+                "cover": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.KakaoProfile": {
+            "type": "object",
+            "properties": {
+                "locale": {
+                    "description": "Locale은 설정한 응답 언어입니다. 설정하지 않았으면 비어 있습니다.",
+                    "type": "string"
+                },
+                "nickName": {
+                    "type": "string"
+                },
+                "timeZone": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.KakaoTokens": {
+            "type": "object",
+            "required": [
+                "accessToken",
+                "idToken",
+                "refreshToken"
+            ],
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "idToken": {
+                    "type": "string"
+                },
+                "refreshToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LocaleDTO": {
+            "type": "object",
+            "properties": {
+                "locale": {
+                    "description": "Locale은 ko 또는 en입니다. 비워서 보내면 설정을 지우고 Accept-Language를 따릅니다.",
+                    "type": "string",
+                    "example": "en"
+                }
+            }
+        },
+        "dto.LotteryEntryDTO": {
+            "type": "object",
+            "required": [
+                "resultDate"
+            ],
+            "properties": {
+                "resultDate": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/models.EntryType"
+                }
+            }
+        },
+        "dto.LotteryPreviewDTO": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer"
+                "date": {
+                    "type": "string"
                 },
-                "data": {},
-                "message": {
+                "resultDate": {
+                    "type": "string"
+                },
+                "scheduleId": {
+                    "type": "string"
+                },
+                "title": {
                     "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/models.EntryType"
                 }
             }
         },
-        "dto.Field": {
+        "dto.LotteryResultDTO": {
+            "type": "object",
+            "required": [
+                "outcome"
+            ],
+            "properties": {
+                "outcome": {
+                    "$ref": "#/definitions/models.LotteryOutcome"
+                }
+            }
+        },
+        "dto.PaletteColorDTO": {
             "type": "object",
             "properties": {
-                "content": {
-                    "type": "string"
+                "color": {
+                    "type": "string",
+                    "example": "0xFF1E3A5F"
                 },
-                "subtitle": {
-                    "type": "string"
+                "ratio": {
+                    "type": "number",
+                    "example": 0.42
                 }
             }
         },
-        "dto.KakaoProfile": {
+        "dto.RefreshTokenRequest": {
             "type": "object",
             "properties": {
-                "nickName": {
+                "refreshToken": {
                     "type": "string"
                 }
             }
         },
-        "dto.KakaoTokens": {
+        "dto.S3UrlDTO": {
             "type": "object",
-            "required": [
-                "accessToken",
-                "idToken",
-                "refreshToken"
-            ],
             "properties": {
-                "accessToken": {
+                "contentType": {
                     "type": "string"
                 },
-                "idToken": {
+                "key": {
                     "type": "string"
                 },
-                "refreshToken": {
+                "size": {
+                    "type": "integer"
+                },
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "dto.RefreshTokenRequest": {
+        "dto.ScheduleCalendarPreviewDTO": {
             "type": "object",
             "properties": {
-                "refreshToken": {
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "imageKey": {
+                    "type": "string"
+                },
+                "imageWebp": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.ScheduleStatus"
+                },
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "dto.ScheduleCalendarPreviewDTO": {
+        "dto.ScheduleConflictDTO": {
             "type": "object",
             "properties": {
+                "conflictIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "date": {
                     "type": "string"
                 },
+                "endTime": {
+                    "type": "string",
+                    "example": "PM-10-00"
+                },
                 "id": {
                     "type": "string"
                 },
-                "image": {
-                    "type": "string"
+                "time": {
+                    "type": "string",
+                    "example": "PM-07-30"
                 },
                 "title": {
                     "type": "string"
@@ -878,6 +2454,7 @@ const docTemplate = `{
             "type": "object",
             "required": [
                 "date",
+                "time",
                 "title"
             ],
             "properties": {
@@ -890,9 +2467,22 @@ const docTemplate = `{
                 "date": {
                     "type": "string"
                 },
+                "duration": {
+                    "type": "integer"
+                },
+                "endTime": {
+                    "type": "string",
+                    "example": "PM-10-00"
+                },
                 "image": {
                     "type": "string"
                 },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ImageInputDTO"
+                    }
+                },
                 "link": {
                     "type": "string"
                 },
@@ -908,10 +2498,17 @@ const docTemplate = `{
                 "seat": {
                     "type": "string"
                 },
+                "status": {
+                    "$ref": "#/definitions/models.ScheduleStatus"
+                },
                 "thumbmail": {
                     "type": "boolean"
                 },
                 "time": {
+                    "type": "string",
+                    "example": "PM-07-30"
+                },
+                "timeZone": {
                     "type": "string"
                 },
                 "title": {
@@ -921,6 +2518,9 @@ const docTemplate = `{
         },
         "dto.ScheduleResponseDTO": {
             "type": "object",
+            "required": [
+                "time"
+            ],
             "properties": {
                 "casting": {
                     "type": "string"
@@ -931,18 +2531,37 @@ const docTemplate = `{
                 "date": {
                     "type": "string"
                 },
+                "duration": {
+                    "type": "integer"
+                },
+                "endTime": {
+                    "type": "string",
+                    "example": "PM-10-00"
+                },
                 "id": {
                     "type": "string"
                 },
                 "image": {
                     "type": "string"
                 },
+                "imageKey": {
+                    "type": "string"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ImageDTO"
+                    }
+                },
                 "link": {
                     "type": "string"
                 },
                 "location": {
                     "type": "string"
                 },
+                "lottery": {
+                    "$ref": "#/definitions/models.LotteryEntry"
+                },
                 "memo": {
                     "type": "string"
                 },
@@ -952,14 +2571,47 @@ const docTemplate = `{
                 "seat": {
                     "type": "string"
                 },
+                "status": {
+                    "$ref": "#/definitions/models.ScheduleStatus"
+                },
+                "statusHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StatusChange"
+                    }
+                },
                 "thumbmail": {
                     "type": "boolean"
                 },
+                "ticketId": {
+                    "type": "string"
+                },
                 "time": {
+                    "type": "string",
+                    "example": "PM-07-30"
+                },
+                "timeZone": {
                     "type": "string"
                 },
                 "title": {
                     "type": "string"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ScheduleWarningDTO"
+                    }
+                }
+            }
+        },
+        "dto.ScheduleStatusDTO": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "$ref": "#/definitions/models.ScheduleStatus"
                 }
             }
         },
@@ -977,11 +2629,44 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.ScheduleWarningDTO": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "scheduleId": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StorageUsageDTO": {
+            "type": "object",
+            "properties": {
+                "files": {
+                    "type": "integer"
+                },
+                "plan": {
+                    "$ref": "#/definitions/models.Plan"
+                },
+                "quotaBytes": {
+                    "type": "integer"
+                },
+                "remainingBytes": {
+                    "type": "integer"
+                },
+                "usedBytes": {
+                    "type": "integer"
+                }
+            }
+        },
         "dto.TicketDTO": {
             "type": "object",
             "required": [
                 "date",
-                "image",
                 "location",
                 "time",
                 "title"
@@ -1005,13 +2690,74 @@ const docTemplate = `{
                 "image": {
                     "type": "string"
                 },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ImageInputDTO"
+                    }
+                },
                 "location": {
                     "type": "string"
                 },
+                "scheduleId": {
+                    "type": "string"
+                },
                 "time": {
+                    "type": "string",
+                    "example": "PM-07-30"
+                },
+                "timeZone": {
+                    "type": "string"
+                },
+                "title": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.TicketDraftConfidenceDTO": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "number"
+                },
+                "location": {
+                    "type": "number"
+                },
+                "seat": {
+                    "type": "number"
+                },
+                "time": {
+                    "type": "number"
                 },
                 "title": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.TicketDraftDTO": {
+            "type": "object",
+            "properties": {
+                "confidence": {
+                    "$ref": "#/definitions/dto.TicketDraftConfidenceDTO"
+                },
+                "text": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ticket": {
+                    "$ref": "#/definitions/dto.TicketDTO"
+                }
+            }
+        },
+        "dto.TicketDraftRequestDTO": {
+            "type": "object",
+            "required": [
+                "key"
+            ],
+            "properties": {
+                "key": {
                     "type": "string"
                 }
             }
@@ -1024,6 +2770,12 @@ const docTemplate = `{
                 },
                 "image": {
                     "type": "string"
+                },
+                "imageKey": {
+                    "type": "string"
+                },
+                "imageWebp": {
+                    "type": "string"
                 }
             }
         },
@@ -1059,6 +2811,10 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "time": {
+                    "type": "string",
+                    "example": "PM-07-30"
+                },
+                "timeZone": {
                     "type": "string"
                 },
                 "title": {
@@ -1066,6 +2822,17 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.TimeZoneDTO": {
+            "type": "object",
+            "required": [
+                "timeZone"
+            ],
+            "properties": {
+                "timeZone": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.TokenResponse": {
             "type": "object",
             "properties": {
@@ -1077,6 +2844,70 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UploadConfirmDTO": {
+            "type": "object",
+            "required": [
+                "key"
+            ],
+            "properties": {
+                "key": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UploadDTO": {
+            "type": "object",
+            "properties": {
+                "contentType": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
+        "health.CheckResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "latencyMs": {
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.Report": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/health.CheckResult"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.EntryType": {
+            "type": "string",
+            "enum": [
+                "LOTTERY",
+                "WAITLIST"
+            ],
+            "x-enum-varnames": [
+                "EntryLottery",
+                "EntryWaitlist"
+            ]
+        },
         "models.Field": {
             "type": "object",
             "properties": {
@@ -1087,6 +2918,78 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "models.LotteryEntry": {
+            "type": "object",
+            "properties": {
+                "decidedAt": {
+                    "type": "string"
+                },
+                "enteredAt": {
+                    "type": "string"
+                },
+                "outcome": {
+                    "$ref": "#/definitions/models.LotteryOutcome"
+                },
+                "resultDate": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/models.EntryType"
+                }
+            }
+        },
+        "models.LotteryOutcome": {
+            "type": "string",
+            "enum": [
+                "PENDING",
+                "WON",
+                "LOST"
+            ],
+            "x-enum-varnames": [
+                "LotteryPending",
+                "LotteryWon",
+                "LotteryLost"
+            ]
+        },
+        "models.Plan": {
+            "type": "string",
+            "enum": [
+                "FREE",
+                "PREMIUM"
+            ],
+            "x-enum-varnames": [
+                "PlanFree",
+                "PlanPremium"
+            ]
+        },
+        "models.ScheduleStatus": {
+            "type": "string",
+            "enum": [
+                "PLANNED",
+                "BOOKED",
+                "ATTENDED",
+                "CANCELLED",
+                "REFUNDED"
+            ],
+            "x-enum-varnames": [
+                "StatusPlanned",
+                "StatusBooked",
+                "StatusAttended",
+                "StatusCancelled",
+                "StatusRefunded"
+            ]
+        },
+        "models.StatusChange": {
+            "type": "object",
+            "properties": {
+                "changedAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.ScheduleStatus"
+                }
+            }
         }
     },
     "securityDefinitions": {
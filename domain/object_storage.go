@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+}
+
+// ObjectStorage는 이미지 원본과 썸네일을 저장하는 저장소입니다
+type ObjectStorage interface {
+	// PresignPut은 Content-Type과 크기가 고정된 업로드 URL을 생성합니다
+	PresignPut(ctx context.Context, key, contentType string, size int64, expires time.Duration) (string, error)
+	PresignGet(ctx context.Context, key string, expires time.Duration) (string, error)
+	Head(ctx context.Context, key string) (*ObjectInfo, error)
+	// ReadPrefix는 객체의 앞부분 n 바이트를 읽습니다
+	ReadPrefix(ctx context.Context, key string, n int64) ([]byte, error)
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Put은 body에서 size 바이트를 읽어 저장합니다. 본문 전체를 메모리에 올리지 않도록 스트림으로 받습니다.
+	Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error
+	Delete(ctx context.Context, key string) error
+	List(ctx context.Context, prefix string) ([]*ObjectInfo, error)
+	// Ping은 저장소에 접근할 수 있는지 확인합니다
+	Ping(ctx context.Context) error
+}
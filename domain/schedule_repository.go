@@ -2,15 +2,34 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/doyeon0307/tickit-backend/models"
 )
 
 type ScheduleRepository interface {
-	GetPreviewsForTicket(ctx context.Context, userId, date string) ([]*models.Schedule, error)
-	GetPreviewsForCalendar(ctx context.Context, userId, startDate, endDate string) ([]*models.Schedule, error)
+	GetPreviewsForTicket(ctx context.Context, userId, date string, statuses []models.ScheduleStatus) ([]*models.Schedule, error)
+	GetPreviewsForCalendar(ctx context.Context, userId, startDate, endDate string, statuses []models.ScheduleStatus) ([]*models.Schedule, error)
 	GetById(ctx context.Context, userId, id string) (*models.Schedule, error)
 	Create(ctx context.Context, schedule *models.Schedule) (string, error)
 	Update(ctx context.Context, userId, id string, schedule *models.Schedule) error
 	Delete(ctx context.Context, userId, id string) error
+	UpdateStatus(ctx context.Context, userId, id string, from, to models.ScheduleStatus, changedAt time.Time) error
+	LinkTicket(ctx context.Context, userId, id, ticketId string) error
+	UnlinkTicket(ctx context.Context, userId, ticketId string) error
+	FindOverlapping(ctx context.Context, userId string, start, end time.Time, excludeId string) ([]*models.Schedule, error)
+	GetActiveEndingAfter(ctx context.Context, userId string, after time.Time) ([]*models.Schedule, error)
+	// GetWithoutInterval은 시작·종료 시각 없이 저장된 기존 일정을 반환합니다
+	GetWithoutInterval(ctx context.Context) ([]*models.Schedule, error)
+	// SetInterval은 시작·종료 시각이 비어 있는 일정에만 start와 end를 채웁니다
+	SetInterval(ctx context.Context, id string, start, end time.Time) error
+	SetLottery(ctx context.Context, userId, id string, entry *models.LotteryEntry) error
+	// DecideLottery는 대기 중인 응모의 결과를 기록하면서 상태를 from에서 to로 함께 바꿉니다. from과 to가 같으면 상태는 그대로 둡니다.
+	DecideLottery(ctx context.Context, userId, id string, outcome models.LotteryOutcome, from, to models.ScheduleStatus, decidedAt time.Time) error
+	GetPendingLotteries(ctx context.Context, userId string) ([]*models.Schedule, error)
+	// UpdateImages는 사진 목록과 대표 사진을 저장하고 image를 대표 사진의 키로 맞춥니다.
+	// 읽은 뒤 사진 목록이 바뀌어 version이 다르면 ErrConflict를 반환합니다.
+	UpdateImages(ctx context.Context, userId, id string, images []models.Image, coverId string, version int) error
+	// ForEachImage는 모든 일정이 참조하는 이미지 값을 차례로 visit에 넘깁니다. 같은 값이 여러 번 전달될 수 있습니다.
+	ForEachImage(ctx context.Context, visit func(image string)) error
 }
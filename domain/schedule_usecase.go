@@ -1,14 +1,27 @@
 package domain
 
 import (
+	"context"
 	"github.com/doyeon0307/tickit-backend/dto"
+	"github.com/doyeon0307/tickit-backend/models"
 )
 
 type ScheduleUsecase interface {
-	GetSchedulePreviewsForTicket(userId, date string) ([]*dto.ScheduleTicketPreviewDTO, error)
-	GetSchedulePreviewsForCalendar(userId, startDate, endDate string) ([]*dto.ScheduleCalendarPreviewDTO, error)
-	GetScheduleById(userId, id string) (*dto.ScheduleResponseDTO, error)
-	CreateSchedule(userId string, schedule *dto.ScheduleDTO) (*dto.ScheduleResponseDTO, error)
-	UpdateSchedule(userId, id string, schedule *dto.ScheduleResponseDTO) (*dto.ScheduleResponseDTO, error)
-	DeleteSchedule(userId, id string) error
+	GetSchedulePreviewsForTicket(ctx context.Context, userId, date string, statuses []models.ScheduleStatus) ([]*dto.ScheduleTicketPreviewDTO, error)
+	GetSchedulePreviewsForCalendar(ctx context.Context, userId, startDate, endDate string, statuses []models.ScheduleStatus) ([]*dto.ScheduleCalendarPreviewDTO, error)
+	GetScheduleById(ctx context.Context, userId, id string) (*dto.ScheduleResponseDTO, error)
+	CreateSchedule(ctx context.Context, userId string, schedule *dto.ScheduleDTO) (*dto.ScheduleResponseDTO, error)
+	UpdateSchedule(ctx context.Context, userId, id string, schedule *dto.ScheduleResponseDTO) (*dto.ScheduleResponseDTO, error)
+	DeleteSchedule(ctx context.Context, userId, id string) error
+	ChangeScheduleStatus(ctx context.Context, userId, id string, status models.ScheduleStatus) (*dto.ScheduleResponseDTO, error)
+	GetScheduleConflicts(ctx context.Context, userId string) ([]*dto.ScheduleConflictDTO, error)
+	// BackfillIntervals는 시작·종료 시각 없이 저장된 기존 일정이 충돌 검사에 포함되도록 두 값을 채우고 채운 일정 수를 반환합니다
+	BackfillIntervals(ctx context.Context) (int, error)
+	RegisterLottery(ctx context.Context, userId, id string, entry *dto.LotteryEntryDTO) (*dto.ScheduleResponseDTO, error)
+	DecideLottery(ctx context.Context, userId, id string, outcome models.LotteryOutcome) (*dto.ScheduleResponseDTO, error)
+	GetPendingLotteries(ctx context.Context, userId string) ([]*dto.LotteryPreviewDTO, error)
+	AddScheduleImage(ctx context.Context, userId, id string, image *dto.ImageInputDTO) ([]dto.ImageDTO, error)
+	UpdateScheduleImage(ctx context.Context, userId, id, imageId string, image *dto.ImageUpdateDTO) ([]dto.ImageDTO, error)
+	RemoveScheduleImage(ctx context.Context, userId, id, imageId string) ([]dto.ImageDTO, error)
+	ReorderScheduleImages(ctx context.Context, userId, id string, imageIds []string) ([]dto.ImageDTO, error)
 }
@@ -0,0 +1,14 @@
+package domain
+
+import "context"
+
+// TextLine은 이미지에서 인식한 한 줄의 글자와 인식 신뢰도(0~1)입니다
+type TextLine struct {
+	Text       string
+	Confidence float64
+}
+
+// TextRecognizer는 이미지에서 글자를 줄 단위로 인식합니다
+type TextRecognizer interface {
+	Recognize(ctx context.Context, image []byte) ([]TextLine, error)
+}
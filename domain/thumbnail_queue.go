@@ -0,0 +1,7 @@
+package domain
+
+// ThumbnailQueue는 업로드된 이미지의 썸네일 생성을 예약합니다.
+// 대기열이 가득 찼거나 종료 중이면 false를 반환하며, 이 경우 원본 이미지가 사용됩니다.
+type ThumbnailQueue interface {
+	Enqueue(key, contentType string) bool
+}
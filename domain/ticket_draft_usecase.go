@@ -0,0 +1,10 @@
+package domain
+
+import (
+	"context"
+	"github.com/doyeon0307/tickit-backend/dto"
+)
+
+type TicketDraftUsecase interface {
+	DraftFromImage(ctx context.Context, userId, key string) (*dto.TicketDraftDTO, error)
+}
@@ -11,5 +11,12 @@ type TicketRepository interface {
 	GetById(ctx context.Context, userId, id string) (*models.Ticket, error)
 	Create(ctx context.Context, userId string, ticket *models.Ticket) (string, error)
 	Update(stx context.Context, userId, id string, ticket *models.Ticket) error
-	Delete(ctx context.Context, id string) error
+	Delete(ctx context.Context, userId, id string) error
+	// UpdateImages는 사진 목록과 대표 사진을 저장하고 image를 대표 사진의 키로 맞춥니다.
+	// 읽은 뒤 사진 목록이 바뀌어 version이 다르면 ErrConflict를 반환합니다.
+	UpdateImages(ctx context.Context, userId, id string, images []models.Image, coverId string, version int) error
+	// ForEachImage는 모든 티켓이 참조하는 이미지 값을 차례로 visit에 넘깁니다. 같은 값이 여러 번 전달될 수 있습니다.
+	ForEachImage(ctx context.Context, visit func(image string)) error
+	// Count는 저장된 티켓 수의 추정치입니다
+	Count(ctx context.Context) (int64, error)
 }
@@ -1,13 +1,18 @@
 package domain
 
 import (
+	"context"
 	"github.com/doyeon0307/tickit-backend/dto"
 )
 
 type TicketUsecase interface {
-	GetTicketPreviews(userId string) ([]*dto.TicketPreview, error)
-	GetTicketByID(userId, id string) (*dto.TicketResponseDTO, error)
-	CreateTicket(userId string, ticket *dto.TicketDTO) (string, error)
-	UpdateTicket(userId, id string, ticket *dto.TicketUpdateDTO) error
-	DeleteTicket(id string) error
+	GetTicketPreviews(ctx context.Context, userId string) ([]*dto.TicketPreview, error)
+	GetTicketByID(ctx context.Context, userId, id string) (*dto.TicketResponseDTO, error)
+	CreateTicket(ctx context.Context, userId string, ticket *dto.TicketDTO) (string, error)
+	UpdateTicket(ctx context.Context, userId, id string, ticket *dto.TicketUpdateDTO) error
+	DeleteTicket(ctx context.Context, userId, id string) error
+	AddTicketImage(ctx context.Context, userId, id string, image *dto.ImageInputDTO) ([]dto.ImageDTO, error)
+	UpdateTicketImage(ctx context.Context, userId, id, imageId string, image *dto.ImageUpdateDTO) ([]dto.ImageDTO, error)
+	RemoveTicketImage(ctx context.Context, userId, id, imageId string) ([]dto.ImageDTO, error)
+	ReorderTicketImages(ctx context.Context, userId, id string, imageIds []string) ([]dto.ImageDTO, error)
 }
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/models"
+)
+
+type UploadRepository interface {
+	Save(ctx context.Context, upload *models.Upload) error
+	GetByKey(ctx context.Context, key string) (*models.Upload, error)
+	GetByKeys(ctx context.Context, keys []string) ([]*models.Upload, error)
+	SetThumbnails(ctx context.Context, key string, widths []int) error
+	// DeleteByKey는 업로드 기록을 지우고 지운 기록을 반환합니다. 기록이 없으면 nil입니다.
+	DeleteByKey(ctx context.Context, key string) (*models.Upload, error)
+	// GetPendingBefore는 before 전에 저장 공간을 예약한 뒤 아직 확인되지 않은 업로드입니다
+	GetPendingBefore(ctx context.Context, before time.Time) ([]*models.Upload, error)
+	// GetUsage는 사용자가 확인을 마친 이미지의 총 크기와 개수입니다
+	GetUsage(ctx context.Context, userId string) (int64, int, error)
+}
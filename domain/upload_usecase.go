@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"context"
+	"io"
+
+	"github.com/doyeon0307/tickit-backend/dto"
+)
+
+type UploadUsecase interface {
+	IssuePresignedUrl(ctx context.Context, userId, contentType string, size int64) (*dto.S3UrlDTO, error)
+	ConfirmUpload(ctx context.Context, userId, key string) (*dto.UploadDTO, error)
+	UploadImage(ctx context.Context, userId string, body io.Reader) (*dto.UploadDTO, error)
+	SuggestColors(ctx context.Context, userId, key string) (*dto.ColorSuggestionDTO, error)
+}
@@ -16,4 +16,14 @@ type UserRepository interface {
 	GetRefreshToken(ctx context.Context, userId string) (string, error)
 	DeleteUser(ctx context.Context, userId string) error
 	RemoveRefreshToken(ctx context.Context, userId string) error
+	UpdateTimeZone(ctx context.Context, userId string, timeZone string) error
+	UpdateLocale(ctx context.Context, userId string, locale string) error
+	// Count는 가입한 사용자 수의 추정치입니다
+	Count(ctx context.Context) (int64, error)
+	// InitStorageUsage는 사용량이 아직 채워지지 않은 사용자의 사용량을 used로 채웁니다
+	InitStorageUsage(ctx context.Context, userId string, used int64) error
+	// ReserveStorage는 사용량에 size를 더한 값이 quota 이하일 때만 더하고, 더했는지 여부를 반환합니다
+	ReserveStorage(ctx context.Context, userId string, size, quota int64) (bool, error)
+	// ReleaseStorage는 예약했던 size를 사용량에서 뺍니다
+	ReleaseStorage(ctx context.Context, userId string, size int64) error
 }
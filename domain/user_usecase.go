@@ -1,19 +1,27 @@
 package domain
 
 import (
+	"context"
 	"time"
 
 	"github.com/doyeon0307/tickit-backend/dto"
+	"github.com/doyeon0307/tickit-backend/i18n"
 	"github.com/doyeon0307/tickit-backend/models"
 )
 
 type UserUsecase interface {
-	GetProfile(id string) (*dto.KakaoProfile, error)
-	CreateUser(idToken string, accessToken string) (string, error)
-	DeleteUser(id string) error
-	GetUserByOAuthId(oauthId string) (*models.User, error)
-	SaveRefreshToken(userId string, refreshToken string, expiryTime time.Time) error
-	ValidateStoredRefreshToken(userId string, refreshToken string) (bool, error)
-	WithdrawUser(userId string) error
-	Logout(userId string) error
+	GetProfile(ctx context.Context, id string) (*dto.KakaoProfile, error)
+	CreateUser(ctx context.Context, idToken string, accessToken string) (string, error)
+	DeleteUser(ctx context.Context, id string) error
+	GetUserByOAuthId(ctx context.Context, oauthId string) (*models.User, error)
+	SaveRefreshToken(ctx context.Context, userId string, refreshToken string, expiryTime time.Time) error
+	ValidateStoredRefreshToken(ctx context.Context, userId string, refreshToken string) (bool, error)
+	WithdrawUser(ctx context.Context, userId string) error
+	Logout(ctx context.Context, userId string) error
+	UpdateTimeZone(ctx context.Context, userId string, timeZone string) error
+	// UpdateLocale은 응답 언어를 설정하고 저장한 값을 반환합니다. 빈 값은 설정을 지웁니다.
+	UpdateLocale(ctx context.Context, userId string, locale string) (string, error)
+	// GetLocale은 사용자가 설정한 응답 언어입니다. 설정하지 않았으면 false를 반환합니다.
+	GetLocale(ctx context.Context, userId string) (i18n.Locale, bool, error)
+	GetStorageUsage(ctx context.Context, userId string) (*dto.StorageUsageDTO, error)
 }
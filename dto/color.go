@@ -0,0 +1,13 @@
+package dto
+
+type PaletteColorDTO struct {
+	Color string  `json:"color" example:"0xFF1E3A5F"`
+	Ratio float64 `json:"ratio" example:"0.42"`
+}
+
+type ColorSuggestionDTO struct {
+	Palette         []PaletteColorDTO `json:"palette"`
+	BackgroundColor string            `json:"backgroundColor" example:"0xFF1E3A5F"`
+	ForegroundColor string            `json:"foregroundColor" example:"0xFFFFFFFF"`
+	ContrastRatio   float64           `json:"contrastRatio" example:"11.7"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+type ImageInputDTO struct {
+	Key     string `json:"key" binding:"required"`
+	Caption string `json:"caption"`
+	Cover   bool   `json:"cover"`
+}
+
+type ImageUpdateDTO struct {
+	Caption *string `json:"caption"`
+	Cover   bool    `json:"cover"`
+}
+
+type ImageOrderDTO struct {
+	ImageIds []string `json:"imageIds" binding:"required"`
+}
+
+type ImageDTO struct {
+	Id       string `json:"id"`
+	Image    string `json:"image"`
+	ImageKey string `json:"imageKey"`
+	Caption  string `json:"caption"`
+	Cover    bool   `json:"cover"`
+}
@@ -1,6 +1,28 @@
 package dto
 
+import "github.com/doyeon0307/tickit-backend/models"
+
 type S3UrlDTO struct {
-	Url string `json:"url"`
-	Key string `json:"key"`
+	Url         string `json:"url"`
+	Key         string `json:"key"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+}
+
+type UploadConfirmDTO struct {
+	Key string `json:"key" binding:"required"`
+}
+
+type UploadDTO struct {
+	Key         string `json:"key"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+}
+
+type StorageUsageDTO struct {
+	Plan           models.Plan `json:"plan"`
+	UsedBytes      int64       `json:"usedBytes"`
+	QuotaBytes     int64       `json:"quotaBytes"`
+	RemainingBytes int64       `json:"remainingBytes"`
+	Files          int         `json:"files"`
 }
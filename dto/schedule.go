@@ -1,10 +1,15 @@
 package dto
 
+import "github.com/doyeon0307/tickit-backend/models"
+
 type ScheduleCalendarPreviewDTO struct {
-	Id    string `json:"id"`
-	Title string `json:"title"`
-	Image string `json:"image"`
-	Date  string `json:"date"`
+	Id        string                `json:"id"`
+	Title     string                `json:"title"`
+	Image     string                `json:"image"`
+	ImageWebp string                `json:"imageWebp,omitempty"`
+	ImageKey  string                `json:"imageKey"`
+	Date      string                `json:"date"`
+	Status    models.ScheduleStatus `json:"status"`
 }
 
 type ScheduleTicketPreviewDTO struct {
@@ -14,32 +19,83 @@ type ScheduleTicketPreviewDTO struct {
 }
 
 type ScheduleDTO struct {
-	Date      string `json:"date" binding:"required"`
-	Title     string `json:"title" binding:"required"`
-	Number    int    `json:"number"`
-	Image     string `json:"image"`
-	Thumbnail bool   `json:"thumbmail"`
-	Location  string `json:"location"`
-	Time      string `json:"time"`
-	Seat      string `json:"seat"`
-	Casting   string `json:"casting"`
-	Company   string `json:"company"`
-	Link      string `json:"link"`
-	Memo      string `json:"memo"`
+	Date      string                `json:"date" binding:"required"`
+	Title     string                `json:"title" binding:"required"`
+	Number    int                   `json:"number"`
+	Image     string                `json:"image"`
+	Images    []ImageInputDTO       `json:"images"`
+	Thumbnail bool                  `json:"thumbmail"`
+	Location  string                `json:"location"`
+	Time      *TimeOfDay            `json:"time" binding:"required" swaggertype:"string" example:"PM-07-30"`
+	Duration  int                   `json:"duration"`
+	EndTime   *TimeOfDay            `json:"endTime" swaggertype:"string" example:"PM-10-00"`
+	TimeZone  string                `json:"timeZone"`
+	Seat      string                `json:"seat"`
+	Casting   string                `json:"casting"`
+	Company   string                `json:"company"`
+	Link      string                `json:"link"`
+	Memo      string                `json:"memo"`
+	Status    models.ScheduleStatus `json:"status"`
 }
 
 type ScheduleResponseDTO struct {
-	Id        string `json:"id"`
-	Date      string `json:"date"`
-	Title     string `json:"title"`
-	Number    int    `json:"number"`
-	Image     string `json:"image"`
-	Thumbnail bool   `json:"thumbmail"`
-	Location  string `json:"location"`
-	Time      string `json:"time"`
-	Seat      string `json:"seat"`
-	Casting   string `json:"casting"`
-	Company   string `json:"company"`
-	Link      string `json:"link"`
-	Memo      string `json:"memo"`
+	Id            string                `json:"id"`
+	Date          string                `json:"date"`
+	Title         string                `json:"title"`
+	Number        int                   `json:"number"`
+	Image         string                `json:"image"`
+	ImageKey      string                `json:"imageKey"`
+	Images        []ImageDTO            `json:"images"`
+	Thumbnail     bool                  `json:"thumbmail"`
+	Location      string                `json:"location"`
+	Time          *TimeOfDay            `json:"time" binding:"required" swaggertype:"string" example:"PM-07-30"`
+	Duration      int                   `json:"duration"`
+	EndTime       *TimeOfDay            `json:"endTime,omitempty" swaggertype:"string" example:"PM-10-00"`
+	TimeZone      string                `json:"timeZone"`
+	Seat          string                `json:"seat"`
+	Casting       string                `json:"casting"`
+	Company       string                `json:"company"`
+	Link          string                `json:"link"`
+	Memo          string                `json:"memo"`
+	Status        models.ScheduleStatus `json:"status"`
+	StatusHistory []models.StatusChange `json:"statusHistory"`
+	TicketId      string                `json:"ticketId"`
+	Lottery       *models.LotteryEntry  `json:"lottery,omitempty"`
+	Warnings      []ScheduleWarningDTO  `json:"warnings,omitempty"`
+}
+
+type LotteryEntryDTO struct {
+	Type       models.EntryType `json:"type"`
+	ResultDate string           `json:"resultDate" binding:"required"`
+}
+
+type LotteryResultDTO struct {
+	Outcome models.LotteryOutcome `json:"outcome" binding:"required"`
+}
+
+type LotteryPreviewDTO struct {
+	ScheduleId string           `json:"scheduleId"`
+	Title      string           `json:"title"`
+	Date       string           `json:"date"`
+	Type       models.EntryType `json:"type"`
+	ResultDate string           `json:"resultDate"`
+}
+
+type ScheduleWarningDTO struct {
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	ScheduleId string `json:"scheduleId"`
+}
+
+type ScheduleConflictDTO struct {
+	Id          string     `json:"id"`
+	Title       string     `json:"title"`
+	Date        string     `json:"date"`
+	Time        *TimeOfDay `json:"time" swaggertype:"string" example:"PM-07-30"`
+	EndTime     *TimeOfDay `json:"endTime,omitempty" swaggertype:"string" example:"PM-10-00"`
+	ConflictIds []string   `json:"conflictIds"`
+}
+
+type ScheduleStatusDTO struct {
+	Status models.ScheduleStatus `json:"status" binding:"required"`
 }
@@ -8,26 +8,33 @@ type Field struct {
 }
 
 type TicketDTO struct {
-	Image           string  `json:"image" binding:"required"`
-	Title           string  `json:"title" binding:"required"`
-	Location        string  `json:"location" binding:"required"`
-	Date            string  `json:"date" binding:"required"`
-	Time            string  `json:"time" binding:"required"`
-	BackgroundColor string  `json:"backgroundColor"`
-	ForegroundColor string  `json:"foregroundColor"`
-	Fields          []Field `json:"fields"`
+	Image           string          `json:"image"`
+	Images          []ImageInputDTO `json:"images"`
+	Title           string          `json:"title" binding:"required"`
+	Location        string          `json:"location" binding:"required"`
+	Date            string          `json:"date" binding:"required"`
+	Time            *TimeOfDay      `json:"time" binding:"required" swaggertype:"string" example:"PM-07-30"`
+	TimeZone        string          `json:"timeZone"`
+	BackgroundColor string          `json:"backgroundColor"`
+	ForegroundColor string          `json:"foregroundColor"`
+	Fields          []Field         `json:"fields"`
+	ScheduleId      string          `json:"scheduleId"`
 }
 
 type TicketResponseDTO struct {
 	Id              string         `json:"id"`
 	Image           string         `json:"image"`
+	ImageKey        string         `json:"imageKey"`
+	Images          []ImageDTO     `json:"images"`
 	Title           string         `json:"title"`
 	Location        string         `json:"location"`
 	Date            string         `json:"date" binding:"required"`
-	Time            string         `json:"time" binding:"required"`
+	Time            *TimeOfDay     `json:"time" binding:"required" swaggertype:"string" example:"PM-07-30"`
+	TimeZone        string         `json:"timeZone"`
 	BackgroundColor string         `json:"backgroundColor"`
 	ForegroundColor string         `json:"foregroundColor"`
 	Fields          []models.Field `json:"fields"`
+	ScheduleId      string         `json:"scheduleId"`
 }
 
 type TicketUpdateDTO struct {
@@ -36,13 +43,35 @@ type TicketUpdateDTO struct {
 	Title           string         `json:"title"`
 	Location        string         `json:"location"`
 	Date            string         `json:"date" binding:"required"`
-	Time            string         `json:"time" binding:"required"`
+	Time            *TimeOfDay     `json:"time" binding:"required" swaggertype:"string" example:"PM-07-30"`
+	TimeZone        string         `json:"timeZone"`
 	BackgroundColor string         `json:"backgroundColor"`
 	ForegroundColor string         `json:"foregroundColor"`
 	Fields          []models.Field `json:"fields"`
 }
 
 type TicketPreview struct {
-	Id    string `json:"id"`
-	Image string `json:"image"`
+	Id        string `json:"id"`
+	Image     string `json:"image"`
+	ImageWebp string `json:"imageWebp,omitempty"`
+	ImageKey  string `json:"imageKey"`
+}
+
+type TicketDraftRequestDTO struct {
+	Key string `json:"key" binding:"required"`
+}
+
+// TicketDraftConfidenceDTO는 항목별 인식 신뢰도(0~1)입니다. 찾지 못한 항목은 0입니다.
+type TicketDraftConfidenceDTO struct {
+	Title    float64 `json:"title"`
+	Location float64 `json:"location"`
+	Date     float64 `json:"date"`
+	Time     float64 `json:"time"`
+	Seat     float64 `json:"seat"`
+}
+
+type TicketDraftDTO struct {
+	Ticket     TicketDTO                `json:"ticket"`
+	Confidence TicketDraftConfidenceDTO `json:"confidence"`
+	Text       []string                 `json:"text"`
 }
@@ -0,0 +1,121 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/common"
+)
+
+var (
+	legacyTimePattern = regexp.MustCompile(`^(AM|PM)-(\d{1,2})-(\d{1,2})$`)
+	clockTimePattern  = regexp.MustCompile(`^(\d{1,2}):(\d{1,2})$`)
+)
+
+// TimeOfDay는 티켓·일정의 시간입니다.
+// 요청에서는 AM/PM-HH-MM, 24시간제 HH:mm, RFC 3339 형식을 받고, 응답은 기존 클라이언트를 위해 AM/PM-HH-MM 형식으로 내려줍니다.
+// RFC 3339 값은 표기된 오프셋 기준의 시각을 사용합니다.
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+}
+
+func NewTimeOfDay(hour, minute int) *TimeOfDay {
+	return &TimeOfDay{
+		Hour:   hour,
+		Minute: minute,
+	}
+}
+
+func timeFormatError(value string, err error) error {
+	return &common.AppError{
+		Code: common.ErrValidation,
+		Key:  "validation.time_format",
+		Args: []any{value},
+		Err:  err,
+	}
+}
+
+func ParseTimeOfDay(value string) (*TimeOfDay, error) {
+	if parts := legacyTimePattern.FindStringSubmatch(value); parts != nil {
+		hour, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, timeFormatError(value, err)
+		}
+		minute, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return nil, timeFormatError(value, err)
+		}
+		if hour < 1 || hour > 12 || minute > 59 {
+			return nil, timeFormatError(value, fmt.Errorf("hour must be 1-12 and minute 0-59"))
+		}
+
+		if parts[1] == "PM" && hour != 12 {
+			hour += 12
+		}
+		if parts[1] == "AM" && hour == 12 {
+			hour = 0
+		}
+		return NewTimeOfDay(hour, minute), nil
+	}
+
+	if parts := clockTimePattern.FindStringSubmatch(value); parts != nil {
+		hour, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, timeFormatError(value, err)
+		}
+		minute, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, timeFormatError(value, err)
+		}
+		if hour > 23 || minute > 59 {
+			return nil, timeFormatError(value, fmt.Errorf("hour must be 0-23 and minute 0-59"))
+		}
+		return NewTimeOfDay(hour, minute), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, timeFormatError(value, err)
+	}
+	return NewTimeOfDay(t.Hour(), t.Minute()), nil
+}
+
+// String은 기존 AM/PM-HH-MM 형식으로 시간을 표현합니다
+func (t TimeOfDay) String() string {
+	ampm := "AM"
+	hour := t.Hour
+
+	if hour >= 12 {
+		ampm = "PM"
+		if hour > 12 {
+			hour -= 12
+		}
+	}
+	if hour == 0 {
+		hour = 12
+	}
+
+	return fmt.Sprintf("%s-%02d-%02d", ampm, hour, t.Minute)
+}
+
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return timeFormatError(string(data), err)
+	}
+
+	parsed, err := ParseTimeOfDay(value)
+	if err != nil {
+		return err
+	}
+	*t = *parsed
+	return nil
+}
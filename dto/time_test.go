@@ -0,0 +1,113 @@
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/doyeon0307/tickit-backend/common"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	tests := []struct {
+		value   string
+		hour    int
+		minute  int
+		wantErr bool
+	}{
+		{value: "AM-09-05", hour: 9, minute: 5},
+		{value: "PM-07-30", hour: 19, minute: 30},
+		{value: "AM-12-00", hour: 0, minute: 0},
+		{value: "PM-12-15", hour: 12, minute: 15},
+		{value: "PM-7-3", hour: 19, minute: 3},
+		{value: "00:00", hour: 0, minute: 0},
+		{value: "9:05", hour: 9, minute: 5},
+		{value: "23:59", hour: 23, minute: 59},
+		{value: "2024-11-23T19:30:00+09:00", hour: 19, minute: 30},
+		{value: "2024-11-23T10:30:00Z", hour: 10, minute: 30},
+		{value: "AM-00-30", wantErr: true},
+		{value: "PM-13-00", wantErr: true},
+		{value: "AM-10-60", wantErr: true},
+		{value: "am-10-00", wantErr: true},
+		{value: "24:00", wantErr: true},
+		{value: "12:60", wantErr: true},
+		{value: "19:30:00", wantErr: true},
+		{value: "2024-11-23", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseTimeOfDay(tt.value)
+			if tt.wantErr {
+				if !common.HasCode(err, common.ErrValidation) {
+					t.Fatalf("ParseTimeOfDay(%q) = %v, %v, want validation error", tt.value, got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTimeOfDay(%q) error: %v", tt.value, err)
+			}
+			if got.Hour != tt.hour || got.Minute != tt.minute {
+				t.Errorf("ParseTimeOfDay(%q) = %02d:%02d, want %02d:%02d", tt.value, got.Hour, got.Minute, tt.hour, tt.minute)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayString(t *testing.T) {
+	tests := []struct {
+		hour   int
+		minute int
+		want   string
+	}{
+		{hour: 0, minute: 0, want: "AM-12-00"},
+		{hour: 9, minute: 5, want: "AM-09-05"},
+		{hour: 12, minute: 0, want: "PM-12-00"},
+		{hour: 19, minute: 30, want: "PM-07-30"},
+		{hour: 23, minute: 59, want: "PM-11-59"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			value := NewTimeOfDay(tt.hour, tt.minute)
+			if got := value.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+
+			// 응답 형식은 다시 요청에 보내도 같은 시간으로 읽혀야 합니다
+			parsed, err := ParseTimeOfDay(value.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *parsed != *value {
+				t.Errorf("ParseTimeOfDay(%q) = %v, want %v", value.String(), parsed, value)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayJSON(t *testing.T) {
+	var body struct {
+		Time *TimeOfDay `json:"time"`
+	}
+	if err := json.Unmarshal([]byte(`{"time":"19:30"}`), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Time == nil || body.Time.Hour != 19 || body.Time.Minute != 30 {
+		t.Fatalf("time = %v, want 19:30", body.Time)
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != `{"time":"PM-07-30"}` {
+		t.Errorf("Marshal = %s, want {\"time\":\"PM-07-30\"}", encoded)
+	}
+
+	for _, invalid := range []string{`{"time":1930}`, `{"time":"25:00"}`} {
+		if err := json.Unmarshal([]byte(invalid), &body); !common.HasCode(err, common.ErrValidation) {
+			t.Errorf("Unmarshal(%s) error = %v, want validation error", invalid, err)
+		}
+	}
+}
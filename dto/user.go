@@ -8,6 +8,18 @@ type KakaoTokens struct {
 
 type KakaoProfile struct {
 	NickName string `json:"nickName"`
+	TimeZone string `json:"timeZone"`
+	// Locale은 설정한 응답 언어입니다. 설정하지 않았으면 비어 있습니다.
+	Locale string `json:"locale,omitempty"`
+}
+
+type TimeZoneDTO struct {
+	TimeZone string `json:"timeZone" binding:"required"`
+}
+
+type LocaleDTO struct {
+	// Locale은 ko 또는 en입니다. 비워서 보내면 설정을 지우고 Accept-Language를 따릅니다.
+	Locale string `json:"locale" example:"en"`
 }
 
 type TokenResponse struct {
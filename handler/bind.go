@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// 검증 오류의 항목 이름을 Go 필드 이름 대신 요청 본문의 JSON 이름으로 표시합니다
+func init() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "-" {
+				return ""
+			}
+			if name == "" {
+				return field.Name
+			}
+			return name
+		})
+	}
+}
+
+// ruleMessages는 검증 규칙별로 사용자에게 보여줄 메시지 키입니다
+var ruleMessages = map[string]string{
+	"required": "validation.required",
+	"type":     "validation.type",
+}
+
+// bindingError는 요청 본문 바인딩 오류를 항목별 원인이 담긴 ErrValidation으로 바꿉니다.
+// 항목을 알 수 없는 오류는 key의 메시지와 함께 ErrBadRequest로 반환합니다.
+func bindingError(err error, key string) error {
+	if _, ok := common.AsAppError(err); ok {
+		return err
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		details := make([]common.FieldError, len(validationErrs))
+		for i, fieldErr := range validationErrs {
+			details[i] = common.FieldError{
+				Field: fieldPath(fieldErr.Namespace()),
+				Rule:  fieldErr.Tag(),
+				Key:   ruleMessage(fieldErr.Tag()),
+			}
+		}
+		return &common.AppError{
+			Code:    common.ErrValidation,
+			Key:     key,
+			Details: details,
+			Err:     err,
+		}
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return &common.AppError{
+			Code:    common.ErrValidation,
+			Key:     key,
+			Details: []common.FieldError{{Field: typeErr.Field, Rule: "type", Key: ruleMessage("type")}},
+			Err:     err,
+		}
+	}
+
+	return common.NewError(common.ErrBadRequest, key, err)
+}
+
+// fieldPath는 검증기의 Namespace(TicketDTO.fields[0].subtitle)에서 구조체 이름을 뺍니다
+func fieldPath(namespace string) string {
+	if _, path, ok := strings.Cut(namespace, "."); ok {
+		return path
+	}
+	return namespace
+}
+
+func ruleMessage(rule string) string {
+	if key, ok := ruleMessages[rule]; ok {
+		return key
+	}
+	return "validation.invalid"
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/doyeon0307/tickit-backend/health"
+	"github.com/gin-gonic/gin"
+)
+
+type HealthHandler struct {
+	registry *health.Registry
+}
+
+// NewHealthHandler는 컨테이너 오케스트레이터와 로드 밸런서가 사용하는 상태 확인 경로를 등록합니다
+func NewHealthHandler(router *gin.Engine, registry *health.Registry) {
+	handler := &HealthHandler{
+		registry: registry,
+	}
+	router.GET("/livez", handler.Livez)
+	router.GET("/readyz", handler.Readyz)
+	router.GET("/api/health", handler.Health)
+}
+
+// @Tags Health
+// @Summary 실행 상태 확인하기
+// @Description 서버 프로세스가 요청에 응답할 수 있는지 확인합니다. 의존하는 서비스는 확인하지 않으므로, 실패하면 서버를 재시작해야 합니다.
+// @Produce json
+// @Success 200 {object} map[string]string
+// @Router /livez [get]
+func (h *HealthHandler) Livez(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"status": health.StatusOK,
+	})
+}
+
+// @Tags Health
+// @Summary 준비 상태 확인하기
+// @Description MongoDB와 이미지 저장소 등 의존하는 서비스를 확인합니다. 하나라도 실패하면 503을 반환하며, checks에 서비스별 상태와 응답 시간이 담깁니다.
+// @Produce json
+// @Success 200 {object} health.Report
+// @Failure 503 {object} health.Report
+// @Router /readyz [get]
+func (h *HealthHandler) Readyz(c *gin.Context) {
+	report := h.registry.Run(c.Request.Context())
+
+	status := http.StatusOK
+	if report.Status != health.StatusOK {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, report)
+}
+
+// @Tags Health
+// @Summary 상태 확인하기
+// @Description /readyz와 같은 준비 상태를 반환합니다. 이전 클라이언트를 위해 남겨 둔 경로이므로 /livez 또는 /readyz를 사용해주세요.
+// @Produce json
+// @Success 200 {object} health.Report
+// @Failure 503 {object} health.Report
+// @Deprecated
+// @Router /api/health [get]
+func (h *HealthHandler) Health(c *gin.Context) {
+	h.Readyz(c)
+}
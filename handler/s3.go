@@ -1,54 +1,184 @@
 package handler
 
 import (
+	"errors"
+	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/doyeon0307/tickit-backend/common"
-	"github.com/doyeon0307/tickit-backend/config"
+	"github.com/doyeon0307/tickit-backend/domain"
 	"github.com/doyeon0307/tickit-backend/dto"
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 )
 
 type S3Handler struct {
-	s3Config *config.S3Config
+	uploadUsecase domain.UploadUsecase
 }
 
-func NewS3Handler(rg *gin.RouterGroup, s3Config *config.S3Config) {
+func NewS3Handler(rg *gin.RouterGroup, usecase domain.UploadUsecase) {
 	handler := &S3Handler{
-		s3Config: s3Config,
+		uploadUsecase: usecase,
 	}
 	s3 := rg.Group("/s3")
 	{
 		s3.GET("/presigned-url", handler.GetPresignedUrl)
+		s3.POST("/confirm", handler.ConfirmUpload)
 	}
+	rg.POST("/images", handler.UploadImage)
+	rg.GET("/images/colors", handler.SuggestColors)
 }
 
+// maxUploadRequestSize는 직접 업로드 요청 본문의 최대 크기입니다.
+// 이미지 크기 제한(10MB)은 UploadUsecase가 검사하고, 여기에는 multipart 경계와 헤더 몫을 더합니다.
+const maxUploadRequestSize = 11 << 20
+
 // @Security ApiKeyAuth
 // @Tags S3
 // @Summary Presigend URL 불러오기
-// @Description Presigend URL를 얻고, 해당 URL을 통해 S3 이미지 업로드를 수행합니다
+// @Description Presigend URL를 얻고, 해당 URL을 통해 S3 이미지 업로드를 수행합니다. 업로드 시 contentType과 size에 맞는 Content-Type, Content-Length 헤더를 보내야 합니다. 업로드 후에는 /api/s3/confirm으로 업로드를 확인합니다. 10MB를 넘는 이미지는 413(TOO_LARGE), 저장 공간이 부족하면 403(QUOTA_EXCEEDED)으로 거부됩니다.
 // @Accept json
 // @Produce json
-// @Success 200 {object} common.Response
+// @Param contentType query string true "이미지 Content-Type (image/jpeg, image/png, image/webp, image/heic, image/heif)"
+// @Param size query int true "이미지 크기 (바이트, 최대 10MB)"
+// @Success 200 {object} common.Response{data=dto.S3UrlDTO}
 // @Router /api/s3/presigned-url [get]
 func (h *S3Handler) GetPresignedUrl(c *gin.Context) {
-	key := uuid.New().String()
+	userId, _ := c.Get("userId")
+
+	size, err := strconv.ParseInt(c.Query("size"), 10, 64)
+	if err != nil {
+		_ = c.Error(common.FieldValidationError("size", "type", "upload.size_required"))
+		return
+	}
+
+	resp, err := h.uploadUsecase.IssuePresignedUrl(c.Request.Context(), userId.(string), c.Query("contentType"), size)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"upload.url_issued",
+		resp,
+	))
+}
 
-	url, err := h.s3Config.MakePresignURL(key)
+// @Security ApiKeyAuth
+// @Tags S3
+// @Summary 업로드 확인하기
+// @Description Presigned URL로 업로드한 이미지가 존재하고 실제 이미지 파일인지 확인합니다. 이미지가 아니면 업로드된 파일은 삭제됩니다.
+// @Accept json
+// @Produce json
+// @Param uploadConfirmDTO body dto.UploadConfirmDTO true "업로드한 이미지 키"
+// @Success 200 {object} common.Response{data=dto.UploadDTO}
+// @Router /api/s3/confirm [post]
+func (h *S3Handler) ConfirmUpload(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	var req dto.UploadConfirmDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(bindingError(err, "request.body_key_required"))
+		return
+	}
+
+	resp, err := h.uploadUsecase.ConfirmUpload(c.Request.Context(), userId.(string), req.Key)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"URL 생성에 실패했습니다",
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"upload.confirmed",
+		resp,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags S3
+// @Summary 이미지 직접 업로드하기
+// @Description Presigned URL 대신 multipart/form-data의 image 필드로 이미지를 서버에 직접 업로드합니다. 파일 내용으로 형식을 판별하며, 업로드 확인까지 함께 처리되므로 /api/s3/confirm을 호출하지 않아도 됩니다. 응답의 key는 Presigned URL 업로드와 같은 형식입니다. 10MB를 넘는 이미지는 413(TOO_LARGE), 저장 공간이 부족하면 403(QUOTA_EXCEEDED)으로 거부됩니다.
+// @Accept multipart/form-data
+// @Produce json
+// @Param image formData file true "이미지 파일 (JPEG, PNG, WebP, HEIC, 최대 10MB)"
+// @Success 200 {object} common.Response{data=dto.UploadDTO}
+// @Router /api/images [post]
+func (h *S3Handler) UploadImage(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	// ParseMultipartForm으로 파일 전체를 먼저 받지 않고 이미지 파트를 바로 넘깁니다.
+	// UploadUsecase가 앞부분으로 형식을 판별한 뒤 나머지를 임시 파일로 받아 저장소에 스트림으로 올립니다.
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxUploadRequestSize)
+	reader, err := c.Request.MultipartReader()
+	if err != nil {
+		_ = c.Error(common.NewError(common.ErrBadRequest, "upload.multipart_required", err))
+		return
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				_ = c.Error(common.NewError(common.ErrTooLarge, "request.too_large", nil))
+				return
+			}
+			_ = c.Error(common.NewError(common.ErrBadRequest, "upload.multipart_invalid", err))
+			return
+		}
+		if part.FormName() != "image" || part.FileName() == "" {
+			continue
+		}
+
+		resp, err := h.uploadUsecase.UploadImage(c.Request.Context(), userId.(string), part)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		c.JSON(http.StatusOK, common.Success(
+			c,
+			http.StatusOK,
+			"upload.image_uploaded",
+			resp,
 		))
 		return
 	}
+
+	_ = c.Error(common.NewError(common.ErrBadRequest, "upload.image_field_required", nil))
+}
+
+// @Security ApiKeyAuth
+// @Tags S3
+// @Summary 이미지로 티켓 색상 추천받기
+// @Description 업로드한 이미지에서 많이 쓰인 색을 뽑고, 가장 많이 쓰인 색을 배경색으로 하여 WCAG AA 명도 대비(4.5:1 이상)를 만족하는 글자색을 추천합니다. 색상은 0xAARRGGBB 형식입니다. HEIC 이미지는 분석할 수 없습니다.
+// @Accept json
+// @Produce json
+// @Param key query string true "업로드한 이미지 키"
+// @Success 200 {object} common.Response{data=dto.ColorSuggestionDTO}
+// @Router /api/images/colors [get]
+func (h *S3Handler) SuggestColors(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	key := c.Query("key")
+	if key == "" {
+		_ = c.Error(common.FieldValidationError("key", "required", "image.key_required"))
+		return
+	}
+
+	resp, err := h.uploadUsecase.SuggestColors(c.Request.Context(), userId.(string), key)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
 	c.JSON(http.StatusOK, common.Success(
+		c,
 		http.StatusOK,
-		"URL 생성에 성공했습니다",
-		dto.S3UrlDTO{
-			Url: url,
-			Key: key,
-		},
+		"image.colors_suggested",
+		resp,
 	))
 }
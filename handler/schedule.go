@@ -2,12 +2,13 @@ package handler
 
 import (
 	"net/http"
-	"regexp"
+	"strings"
 	"time"
 
 	"github.com/doyeon0307/tickit-backend/common"
 	"github.com/doyeon0307/tickit-backend/domain"
 	"github.com/doyeon0307/tickit-backend/dto"
+	"github.com/doyeon0307/tickit-backend/models"
 	"github.com/gin-gonic/gin"
 )
 
@@ -22,56 +23,66 @@ func NewScheduleHandler(rg *gin.RouterGroup, usecase domain.ScheduleUsecase) {
 	schedules := rg.Group("/schedules")
 	{
 		schedules.GET("/for-ticket", handler.GetSchedulePreviewsForTicket)
+		schedules.GET("/conflicts", handler.GetScheduleConflicts)
+		schedules.GET("/lotteries", handler.GetPendingLotteries)
 		schedules.GET("", handler.GetSchedulePreviewsForCalendar)
 		schedules.GET("/:id", handler.GetScheduleById)
 		schedules.POST("", handler.CreateSchedule)
 		schedules.PUT("/:id", handler.UpdateSchedule)
 		schedules.DELETE("/:id", handler.DeleteSchedule)
+		schedules.PATCH("/:id/status", handler.ChangeScheduleStatus)
+		schedules.PUT("/:id/lottery", handler.RegisterLottery)
+		schedules.PATCH("/:id/lottery", handler.DecideLottery)
+		schedules.POST("/:id/images", handler.AddScheduleImage)
+		schedules.PUT("/:id/images/order", handler.ReorderScheduleImages)
+		schedules.PATCH("/:id/images/:imageId", handler.UpdateScheduleImage)
+		schedules.DELETE("/:id/images/:imageId", handler.RemoveScheduleImage)
 	}
 }
 
+// statusQuery는 콤마로 구분된 status 쿼리를 일정 상태 목록으로 변환합니다
+func statusQuery(c *gin.Context) []models.ScheduleStatus {
+	statuses := make([]models.ScheduleStatus, 0)
+	for _, value := range strings.Split(c.Query("status"), ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		statuses = append(statuses, models.ScheduleStatus(strings.ToUpper(value)))
+	}
+	return statuses
+}
+
 // @Security ApiKeyAuth
 // @Tags Schedules
 // @Summary 티켓 생성 가능한 일정 목록 불러오기
-// @Description 현 날짜 이전의 일정 목록을 불러옵니다. 티켓 생성 화면의'일정 불러오기' 버튼에서 사용됩니다.
+// @Description 현 날짜 이전의 일정 중 아직 티켓으로 만들지 않은 일정 목록을 불러옵니다. 상태를 지정하지 않으면 ATTENDED 일정만 불러옵니다. 티켓 생성 화면의'일정 불러오기' 버튼에서 사용됩니다.
 // @Accept json
 // @Produce json
-// @Param date query string false "오늘 날짜"
+// @Param date query string false "오늘 날짜 (기본값: 사용자 시간대 기준 오늘)"
+// @Param status query string false "일정 상태 (PLANNED, BOOKED, ATTENDED, CANCELLED, REFUNDED), 콤마로 구분"
 // @Success 200 {object} common.Response{data=dto.ScheduleTicketPreviewDTO}
 // @Router /api/schedules/for-ticket [get]
 func (h *ScheduleHandler) GetSchedulePreviewsForTicket(c *gin.Context) {
 	userId, _ := c.Get("userId")
+	// 날짜를 입력하지 않으면 사용자 시간대 기준의 오늘 날짜를 사용합니다
 	date := c.Query("date")
-	if date == "" {
-		date = time.Now().Format("2006-01-02")
-	} else {
+	if date != "" {
 		_, err := time.Parse("2006-01-02", date)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, common.Error(
-				http.StatusBadRequest,
-				"날짜 형식이 잘못되었습니다. YYYY-MM-DD 형식으로 입력해주세요.",
-			))
+			_ = c.Error(common.FieldValidationError("date", "format", "validation.date_format"))
 			return
 		}
 	}
-	previews, err := h.scheduleUsecase.GetSchedulePreviewsForTicket(userId.(string), date)
+	previews, err := h.scheduleUsecase.GetSchedulePreviewsForTicket(c.Request.Context(), userId.(string), date, statusQuery(c))
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"일정 불러오기에 실패했습니다",
-		))
+		_ = c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, common.Success(
+		c,
 		http.StatusOK,
-		"티켓으로 만들 수 있는 일정 목록 불러오기에 성공했습니다",
+		"schedule.ticketable_listed",
 		previews,
 	))
 }
@@ -79,11 +90,12 @@ func (h *ScheduleHandler) GetSchedulePreviewsForTicket(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Tags Schedules
 // @Summary 달력에 일정 목록 불러오기
-// @Description 시작 날짜와 종료 날짜 사이의 일정 목록을 불러옵니다
+// @Description 시작 날짜와 종료 날짜 사이의 일정 목록을 불러옵니다. image는 1시간 동안 유효한 서명된 URL이며, 저장된 키는 imageKey로 내려갑니다.
 // @Accept json
 // @Produce json
 // @Param startDate query string true "시작 날짜"
 // @Param endDate query string true "종료 날짜"
+// @Param status query string false "일정 상태 (PLANNED, BOOKED, ATTENDED, CANCELLED, REFUNDED), 콤마로 구분"
 // @Success 200 {object} common.Response{data=dto.ScheduleCalendarPreviewDTO}
 // @Router /api/schedules [get]
 func (h *ScheduleHandler) GetSchedulePreviewsForCalendar(c *gin.Context) {
@@ -93,47 +105,56 @@ func (h *ScheduleHandler) GetSchedulePreviewsForCalendar(c *gin.Context) {
 	endDate := c.Query("endDate")
 
 	if _, err := time.Parse("2006-01-02", startDate); err != nil {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"시작 날짜 형식이 잘못되었습니다. YYYY-MM-DD 형식으로 입력해주세요.",
-		))
+		_ = c.Error(common.FieldValidationError("startDate", "format", "validation.start_date_format"))
 		return
 	}
 
 	if _, err := time.Parse("2006-01-02", endDate); err != nil {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"종료 날짜 형식이 잘못되었습니다. YYYY-MM-DD 형식으로 입력해주세요.",
-		))
+		_ = c.Error(common.FieldValidationError("endDate", "format", "validation.end_date_format"))
 		return
 	}
 
-	previews, err := h.scheduleUsecase.GetSchedulePreviewsForCalendar(userId.(string), startDate, endDate)
+	previews, err := h.scheduleUsecase.GetSchedulePreviewsForCalendar(c.Request.Context(), userId.(string), startDate, endDate, statusQuery(c))
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"일정 불러오기에 실패했습니다",
-		))
+		_ = c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, common.Success(
+		c,
 		http.StatusOK,
-		"일정 목록 불러오기에 성공했습니다",
+		"schedule.listed",
 		previews,
 	))
 }
 
+// @Security ApiKeyAuth
+// @Tags Schedules
+// @Summary 겹치는 일정 목록 불러오기
+// @Description 아직 끝나지 않은 일정 중 시간이 겹치는 일정 목록을 불러옵니다. 취소되거나 환불된 일정은 제외됩니다.
+// @Accept json
+// @Produce json
+// @Success 200 {object} common.Response{data=[]dto.ScheduleConflictDTO}
+// @Router /api/schedules/conflicts [get]
+func (h *ScheduleHandler) GetScheduleConflicts(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	conflicts, err := h.scheduleUsecase.GetScheduleConflicts(c.Request.Context(), userId.(string))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"schedule.overlaps_listed",
+		conflicts,
+	))
+}
+
 // @Security ApiKeyAuth
 // @Tags Schedules
 // @Summary 세부 일정 불러오기
-// @Description 세부 일정을 불러옵니다
+// @Description 세부 일정을 불러옵니다. image는 대표 사진의 1시간 동안 유효한 서명된 URL이며, 저장된 키는 imageKey로 내려갑니다. images에는 모든 사진이 순서대로 담깁니다.
 // @Accept json
 // @Produce json
 // @Param id path string true "일정 ID"
@@ -144,30 +165,18 @@ func (h *ScheduleHandler) GetScheduleById(c *gin.Context) {
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"아이디를 입력해주세요",
-		))
+		_ = c.Error(common.NewError(common.ErrBadRequest, "request.id_required", nil))
 	}
 
-	schedule, err := h.scheduleUsecase.GetScheduleById(userId.(string), id)
+	schedule, err := h.scheduleUsecase.GetScheduleById(c.Request.Context(), userId.(string), id)
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusNotFound, common.Error(
-			http.StatusNotFound,
-			"일정 조회에 실패했습니다. 아이디를 확인해주세요.",
-		))
+		_ = c.Error(err)
 		return
 	}
 	c.JSON(http.StatusAccepted, common.Success(
+		c,
 		http.StatusAccepted,
-		"세부 일정 조회에 성공했습니다",
+		"schedule.loaded",
 		schedule,
 	))
 }
@@ -175,7 +184,7 @@ func (h *ScheduleHandler) GetScheduleById(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Tags Schedules
 // @Summary 일정 생성하기
-// @Description 일정을 생성합니다. presigned-url을 발급받아 이미지 업로드를 완료한 후에, s3 url을 image 값으로 저장합니다. 날짜 형식은 YYYY-MM-DD, 시간 형식은 AM/PM-HH-MM입니다.
+// @Description 일정을 생성합니다. presigned-url을 발급받아 이미지 업로드와 확인(/api/s3/confirm)을 완료한 후에, 발급받은 key를 image 값으로 저장합니다. 여러 장을 등록할 때는 images에 순서대로 담고, image 또는 cover로 대표 사진을 지정합니다. 본인이 업로드한 이미지만 사용할 수 있습니다. 날짜 형식은 YYYY-MM-DD, 시간 형식은 AM/PM-HH-MM입니다. 종료 시간(endTime) 또는 소요 시간(duration, 분)을 입력할 수 있으며, 다른 일정과 시간이 겹치면 warnings에 해당 일정이 포함됩니다.
 // @Accept json
 // @Produce json
 // @Param scheduleDTO body dto.ScheduleDTO true "일정 DTO"
@@ -186,48 +195,24 @@ func (h *ScheduleHandler) CreateSchedule(c *gin.Context) {
 
 	var schedule dto.ScheduleDTO
 	if err := c.ShouldBindJSON(&schedule); err != nil {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"Request Body가 올바르지 않습니다",
-		))
+		_ = c.Error(bindingError(err, "request.body_invalid"))
 		return
 	}
 
 	if _, err := time.Parse("2006-01-02", schedule.Date); err != nil {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"날짜 형식이 잘못되었습니다. YYYY-MM-DD 형식으로 입력해주세요.",
-		))
+		_ = c.Error(common.FieldValidationError("date", "format", "validation.date_format"))
 		return
 	}
 
-	timePattern := `^(AM|PM)-(?:0[1-9]|1[0-2])-(?:[0-5][0-9])$`
-	if !regexp.MustCompile(timePattern).MatchString(schedule.Time) {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"시간 형식이 잘못되었습니다. AM/PM-HH-MM 형식으로 입력해주세요.",
-		))
-		return
-	}
-
-	resp, err := h.scheduleUsecase.CreateSchedule(userId.(string), &schedule)
+	resp, err := h.scheduleUsecase.CreateSchedule(c.Request.Context(), userId.(string), &schedule)
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"일정 생성에 실패했습니다",
-		))
+		_ = c.Error(err)
 		return
 	}
 	c.JSON(http.StatusAccepted, common.Success(
+		c,
 		http.StatusAccepted,
-		"일정 생성에 성공했습니다",
+		"schedule.created",
 		resp,
 	))
 }
@@ -235,7 +220,7 @@ func (h *ScheduleHandler) CreateSchedule(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Tags Schedules
 // @Summary 일정 수정하기
-// @Description 일정을 수정합니다. presigned-url을 발급받아 이미지 업로드를 완료한 후에, s3 url을 image 값으로 저장합니다.
+// @Description 일정을 수정합니다. image는 대표 사진을 교체하며, 사진 목록은 /api/schedules/{id}/images 경로로 수정합니다(images는 무시됩니다). 본인이 업로드한 이미지만 사용할 수 있습니다. 다른 일정과 시간이 겹치면 warnings에 해당 일정이 포함됩니다.
 // @Accept json
 // @Produce json
 // @Param id path string true "일정 ID"
@@ -249,53 +234,28 @@ func (h *ScheduleHandler) UpdateSchedule(c *gin.Context) {
 	var schedule dto.ScheduleResponseDTO
 
 	if id == "" {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"아이디를 입력해주세요",
-		))
-	}
-
-	if _, err := h.scheduleUsecase.GetScheduleById(userId.(string), id); err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusNotFound, common.Error(
-			http.StatusNotFound,
-			"일정 조회에 실패했습니다. 아이디를 확인해주세요.",
-		))
+		_ = c.Error(common.NewError(common.ErrBadRequest, "request.id_required", nil))
+	}
+
+	if _, err := h.scheduleUsecase.GetScheduleById(c.Request.Context(), userId.(string), id); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
 	if err := c.ShouldBindJSON(&schedule); err != nil {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"Request Body가 올바르지 않습니다",
-		))
+		_ = c.Error(bindingError(err, "request.body_invalid"))
 		return
 	}
 
-	resp, err := h.scheduleUsecase.UpdateSchedule(userId.(string), id, &schedule)
+	resp, err := h.scheduleUsecase.UpdateSchedule(c.Request.Context(), userId.(string), id, &schedule)
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"일정 수정에 실패했습니다",
-		))
+		_ = c.Error(err)
 		return
 	}
 	c.JSON(http.StatusAccepted, common.Success(
+		c,
 		http.StatusAccepted,
-		"일정이 수정되었습니다",
+		"schedule.updated",
 		resp,
 	))
 }
@@ -314,45 +274,283 @@ func (h *ScheduleHandler) DeleteSchedule(c *gin.Context) {
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"아이디를 입력해주세요",
-		))
-	}
-
-	if _, err := h.scheduleUsecase.GetScheduleById(userId.(string), id); err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusNotFound, common.Error(
-			http.StatusNotFound,
-			"일정 조회에 실패했습니다. 아이디를 확인해주세요.",
-		))
+		_ = c.Error(common.NewError(common.ErrBadRequest, "request.id_required", nil))
+	}
+
+	if _, err := h.scheduleUsecase.GetScheduleById(c.Request.Context(), userId.(string), id); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
-	err := h.scheduleUsecase.DeleteSchedule(userId.(string), id)
+	err := h.scheduleUsecase.DeleteSchedule(c.Request.Context(), userId.(string), id)
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"일정 삭제에 실패했습니다",
-		))
+		_ = c.Error(err)
 		return
 	}
 	c.JSON(http.StatusAccepted, common.Success(
+		c,
 		http.StatusAccepted,
-		"일정이 삭제되었습니다",
+		"schedule.deleted",
 		id,
 	))
 }
+
+// @Security ApiKeyAuth
+// @Tags Schedules
+// @Summary 일정 상태 변경하기
+// @Description 일정 상태를 변경합니다. PLANNED → BOOKED/CANCELLED, BOOKED → ATTENDED/CANCELLED/REFUNDED, CANCELLED → REFUNDED 순서로만 변경할 수 있습니다. 시작 시간이 지난 PLANNED 일정은 ATTENDED로 바로 변경할 수 있습니다.
+// @Accept json
+// @Produce json
+// @Param id path string true "일정 ID"
+// @Param statusDTO body dto.ScheduleStatusDTO true "변경할 상태"
+// @Success 200 {object} common.Response{data=dto.ScheduleResponseDTO}
+// @Router /api/schedules/{id}/status [patch]
+func (h *ScheduleHandler) ChangeScheduleStatus(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	id := c.Param("id")
+
+	var req dto.ScheduleStatusDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(bindingError(err, "request.body_invalid"))
+		return
+	}
+
+	resp, err := h.scheduleUsecase.ChangeScheduleStatus(c.Request.Context(), userId.(string), id, models.ScheduleStatus(strings.ToUpper(string(req.Status))))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusAccepted, common.Success(
+		c,
+		http.StatusAccepted,
+		"schedule.status_changed",
+		resp,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Schedules
+// @Summary 결과 대기 중인 응모 목록 불러오기
+// @Description 추첨·취소표 대기 응모 중 결과가 나오지 않은 목록을 결과 발표일 순으로 불러옵니다
+// @Accept json
+// @Produce json
+// @Success 200 {object} common.Response{data=[]dto.LotteryPreviewDTO}
+// @Router /api/schedules/lotteries [get]
+func (h *ScheduleHandler) GetPendingLotteries(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	previews, err := h.scheduleUsecase.GetPendingLotteries(c.Request.Context(), userId.(string))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"lottery.listed",
+		previews,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Schedules
+// @Summary 응모 등록하기
+// @Description PLANNED 상태의 일정에 추첨(LOTTERY) 또는 취소표 대기(WAITLIST) 응모를 등록합니다. 결과 발표일 형식은 YYYY-MM-DD입니다. 결과가 나온 응모는 새 응모로 바뀌지만, 결과를 기다리는 응모가 있으면 409를 반환합니다.
+// @Accept json
+// @Produce json
+// @Param id path string true "일정 ID"
+// @Param lotteryEntryDTO body dto.LotteryEntryDTO true "응모 정보"
+// @Success 200 {object} common.Response{data=dto.ScheduleResponseDTO}
+// @Router /api/schedules/{id}/lottery [put]
+func (h *ScheduleHandler) RegisterLottery(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	id := c.Param("id")
+
+	var req dto.LotteryEntryDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(bindingError(err, "request.body_invalid"))
+		return
+	}
+
+	req.Type = models.EntryType(strings.ToUpper(string(req.Type)))
+	resp, err := h.scheduleUsecase.RegisterLottery(c.Request.Context(), userId.(string), id, &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusAccepted, common.Success(
+		c,
+		http.StatusAccepted,
+		"lottery.entered",
+		resp,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Schedules
+// @Summary 응모 결과 입력하기
+// @Description 응모 결과(WON, LOST)를 입력합니다. 당첨(WON)되면 일정이 BOOKED 상태로 바뀝니다.
+// @Accept json
+// @Produce json
+// @Param id path string true "일정 ID"
+// @Param lotteryResultDTO body dto.LotteryResultDTO true "응모 결과"
+// @Success 200 {object} common.Response{data=dto.ScheduleResponseDTO}
+// @Router /api/schedules/{id}/lottery [patch]
+func (h *ScheduleHandler) DecideLottery(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	id := c.Param("id")
+
+	var req dto.LotteryResultDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(bindingError(err, "request.body_invalid"))
+		return
+	}
+
+	resp, err := h.scheduleUsecase.DecideLottery(c.Request.Context(), userId.(string), id, models.LotteryOutcome(strings.ToUpper(string(req.Outcome))))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusAccepted, common.Success(
+		c,
+		http.StatusAccepted,
+		"lottery.decided",
+		resp,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Schedules
+// @Summary 일정 사진 추가하기
+// @Description 일정에 사진을 추가합니다. 업로드 확인(/api/s3/confirm)을 마친 key를 사용하며, 사진은 10장까지 등록할 수 있습니다. cover가 true이거나 첫 사진이면 대표 사진이 됩니다.
+// @Accept json
+// @Produce json
+// @Param id path string true "일정 ID"
+// @Param imageInputDTO body dto.ImageInputDTO true "추가할 사진"
+// @Success 201 {object} common.Response{data=[]dto.ImageDTO}
+// @Router /api/schedules/{id}/images [post]
+func (h *ScheduleHandler) AddScheduleImage(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	id := c.Param("id")
+
+	var req dto.ImageInputDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(bindingError(err, "request.body_key_required"))
+		return
+	}
+
+	resp, err := h.scheduleUsecase.AddScheduleImage(c.Request.Context(), userId.(string), id, &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusCreated, common.Success(
+		c,
+		http.StatusCreated,
+		"gallery.image_added",
+		resp,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Schedules
+// @Summary 일정 사진 수정하기
+// @Description 사진 설명을 수정하거나 대표 사진으로 지정합니다. caption을 보내지 않으면 설명은 그대로 유지됩니다.
+// @Accept json
+// @Produce json
+// @Param id path string true "일정 ID"
+// @Param imageId path string true "사진 ID"
+// @Param imageUpdateDTO body dto.ImageUpdateDTO true "수정할 내용"
+// @Success 200 {object} common.Response{data=[]dto.ImageDTO}
+// @Router /api/schedules/{id}/images/{imageId} [patch]
+func (h *ScheduleHandler) UpdateScheduleImage(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	id := c.Param("id")
+	imageId := c.Param("imageId")
+
+	var req dto.ImageUpdateDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(bindingError(err, "request.body_invalid"))
+		return
+	}
+
+	resp, err := h.scheduleUsecase.UpdateScheduleImage(c.Request.Context(), userId.(string), id, imageId, &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"gallery.image_updated",
+		resp,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Schedules
+// @Summary 일정 사진 삭제하기
+// @Description 사진을 삭제합니다. 대표 사진을 삭제하면 남은 사진 중 첫 번째 사진이 대표 사진이 됩니다.
+// @Accept json
+// @Produce json
+// @Param id path string true "일정 ID"
+// @Param imageId path string true "사진 ID"
+// @Success 200 {object} common.Response{data=[]dto.ImageDTO}
+// @Router /api/schedules/{id}/images/{imageId} [delete]
+func (h *ScheduleHandler) RemoveScheduleImage(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	id := c.Param("id")
+	imageId := c.Param("imageId")
+
+	resp, err := h.scheduleUsecase.RemoveScheduleImage(c.Request.Context(), userId.(string), id, imageId)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"gallery.image_removed",
+		resp,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Schedules
+// @Summary 일정 사진 순서 변경하기
+// @Description 사진을 imageIds 순서로 정렬합니다. 모든 사진의 ID를 한 번씩 보내야 합니다.
+// @Accept json
+// @Produce json
+// @Param id path string true "일정 ID"
+// @Param imageOrderDTO body dto.ImageOrderDTO true "사진 ID 순서"
+// @Success 200 {object} common.Response{data=[]dto.ImageDTO}
+// @Router /api/schedules/{id}/images/order [put]
+func (h *ScheduleHandler) ReorderScheduleImages(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	id := c.Param("id")
+
+	var req dto.ImageOrderDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(bindingError(err, "request.body_image_ids_required"))
+		return
+	}
+
+	resp, err := h.scheduleUsecase.ReorderScheduleImages(c.Request.Context(), userId.(string), id, req.ImageIds)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"gallery.reordered",
+		resp,
+	))
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"github.com/doyeon0307/tickit-backend/storage"
+	"github.com/doyeon0307/tickit-backend/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// StorageHandler는 로컬 저장소가 발급한 서명된 URL로 들어오는 업로드와 다운로드를 처리합니다
+type StorageHandler struct {
+	storage *storage.LocalStorage
+}
+
+func NewStorageHandler(router *gin.Engine, local *storage.LocalStorage) {
+	handler := &StorageHandler{
+		storage: local,
+	}
+	files := router.Group(strings.TrimSuffix(utils.LocalStoragePath, "/"))
+	{
+		files.PUT("/*key", handler.PutObject)
+		files.GET("/*key", handler.GetObject)
+	}
+}
+
+// @Tags Storage
+// @Summary 로컬 저장소에 업로드하기
+// @Description 로컬 저장소 사용 시 /api/s3/presigned-url이 발급한 URL입니다. 발급 시 지정한 Content-Type, Content-Length 헤더와 함께 이미지를 보냅니다.
+// @Accept octet-stream
+// @Produce json
+// @Param key path string true "객체 키"
+// @Param expires query int true "만료 시각 (Unix)"
+// @Param contentType query string true "Content-Type"
+// @Param size query int true "크기 (바이트)"
+// @Param signature query string true "서명"
+// @Success 200 {object} common.Response
+// @Router /storage/{key} [put]
+func (h *StorageHandler) PutObject(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+
+	if !h.storage.Verify(http.MethodPut, key, c.Request.URL.Query()) {
+		_ = c.Error(common.NewError(common.ErrForbidden, "storage.url_invalid", nil))
+		return
+	}
+
+	// 서명이 확인되었으므로 size는 올바른 숫자입니다
+	size, _ := strconv.ParseInt(c.Query("size"), 10, 64)
+	if c.ContentType() != c.Query("contentType") || c.Request.ContentLength != size {
+		_ = c.Error(common.NewError(common.ErrBadRequest, "storage.header_mismatch", nil))
+		return
+	}
+
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, size)
+	if err := h.storage.Write(key, body); err != nil {
+		_ = c.Error(common.ServerError(c, "storage.upload_failed", err))
+		return
+	}
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"storage.uploaded",
+		nil,
+	))
+}
+
+// @Tags Storage
+// @Summary 로컬 저장소에서 내려받기
+// @Description 로컬 저장소 사용 시 이미지 응답에 포함되는 URL입니다.
+// @Produce octet-stream
+// @Param key path string true "객체 키"
+// @Param expires query int true "만료 시각 (Unix)"
+// @Param signature query string true "서명"
+// @Success 200 {file} file
+// @Router /storage/{key} [get]
+func (h *StorageHandler) GetObject(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+
+	if !h.storage.Verify(http.MethodGet, key, c.Request.URL.Query()) {
+		_ = c.Error(common.NewError(common.ErrForbidden, "storage.url_invalid", nil))
+		return
+	}
+
+	path, err := h.storage.Path(key)
+	if err == nil {
+		_, err = os.Stat(path)
+	}
+	if err != nil {
+		_ = c.Error(common.NewError(common.ErrNotFound, "storage.image_not_found", err))
+		return
+	}
+	c.File(path)
+}
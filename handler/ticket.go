@@ -2,7 +2,6 @@ package handler
 
 import (
 	"net/http"
-	"regexp"
 	"time"
 
 	"github.com/doyeon0307/tickit-backend/common"
@@ -14,26 +13,33 @@ import (
 
 type TicketHandler struct {
 	ticketUsecase domain.TicketUsecase
+	draftUsecase  domain.TicketDraftUsecase
 }
 
-func NewTicketHandler(rg *gin.RouterGroup, usecase domain.TicketUsecase) {
+func NewTicketHandler(rg *gin.RouterGroup, usecase domain.TicketUsecase, draftUsecase domain.TicketDraftUsecase) {
 	handler := &TicketHandler{
 		ticketUsecase: usecase,
+		draftUsecase:  draftUsecase,
 	}
 	tickets := rg.Group("/tickets")
 	{
 		tickets.GET("", handler.GetTicketPreviews)
 		tickets.GET("/:id", handler.GetTicketById)
 		tickets.POST("", handler.MakeTicket)
+		tickets.POST("/draft-from-image", handler.DraftTicketFromImage)
 		tickets.PUT("/:id", handler.UpdateTicket)
 		tickets.DELETE("/:id", handler.DeleteTicket)
+		tickets.POST("/:id/images", handler.AddTicketImage)
+		tickets.PUT("/:id/images/order", handler.ReorderTicketImages)
+		tickets.PATCH("/:id/images/:imageId", handler.UpdateTicketImage)
+		tickets.DELETE("/:id/images/:imageId", handler.RemoveTicketImage)
 	}
 }
 
 // @Security ApiKeyAuth
 // @Tags Tickets
 // @Summary 티켓 목록 불러오기
-// @Description 홈 화면에 작성한 티켓 목록을 불러옵니다
+// @Description 홈 화면에 작성한 티켓 목록을 불러옵니다. image는 대표 사진의 1시간 동안 유효한 서명된 URL이며, 저장된 키는 imageKey로 내려갑니다.
 // @Accept json
 // @Produce json
 // @Success 200 {object} common.Response{data=dto.TicketPreview}
@@ -41,24 +47,15 @@ func NewTicketHandler(rg *gin.RouterGroup, usecase domain.TicketUsecase) {
 func (h *TicketHandler) GetTicketPreviews(c *gin.Context) {
 	userId, _ := c.Get("userId")
 
-	previews, err := h.ticketUsecase.GetTicketPreviews(userId.(string))
+	previews, err := h.ticketUsecase.GetTicketPreviews(c.Request.Context(), userId.(string))
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"티켓 목록 불러오기에 실패했습니다",
-		))
+		_ = c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, common.Success(
+		c,
 		http.StatusOK,
-		"티켓 목록 불러오기에 성공했습니다",
+		"ticket.listed",
 		previews,
 	))
 }
@@ -66,7 +63,7 @@ func (h *TicketHandler) GetTicketPreviews(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Tags Tickets
 // @Summary 티켓 세부정보 불러오기
-// @Description 티켓 아이디로 세부정보를 불러옵니다
+// @Description 티켓 아이디로 세부정보를 불러옵니다. image는 대표 사진의 1시간 동안 유효한 서명된 URL이며, 저장된 키는 imageKey로 내려갑니다. images에는 모든 사진이 순서대로 담깁니다.
 // @Accept json
 // @Produce json
 // @Param id path string true "티켓 ID"
@@ -76,25 +73,16 @@ func (h *TicketHandler) GetTicketById(c *gin.Context) {
 	userId, _ := c.Get("userId")
 
 	id := c.Param("id")
-	ticket, err := h.ticketUsecase.GetTicketByID(userId.(string), id)
+	ticket, err := h.ticketUsecase.GetTicketByID(c.Request.Context(), userId.(string), id)
 
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"티켓 불러오기에 실패했습니다",
-		))
+		_ = c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, common.Success(
+		c,
 		http.StatusOK,
-		"티켓 불러오기에 성공했습니다",
+		"ticket.loaded",
 		ticket,
 	))
 }
@@ -102,7 +90,7 @@ func (h *TicketHandler) GetTicketById(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Tags Tickets
 // @Summary 티켓 생성하기
-// @Description 티켓을 생성합니다. presigned-url을 발급받아 이미지 업로드를 완료한 후에, s3 url을 image 값으로 저장합니다. 날짜 형식은 YYYY-MM-DD, 시간 형식은 AM/PM-HH-MM입니다.
+// @Description 티켓을 생성합니다. presigned-url을 발급받아 이미지 업로드와 확인(/api/s3/confirm)을 완료한 후에, 발급받은 key를 image 값으로 저장합니다. 여러 장을 등록할 때는 images에 순서대로 담고, image 또는 cover로 대표 사진을 지정합니다. 본인이 업로드한 이미지만 사용할 수 있습니다. 날짜 형식은 YYYY-MM-DD, 시간 형식은 AM/PM-HH-MM입니다. backgroundColor와 foregroundColor는 0xAARRGGBB 또는 #RRGGBB 형식이며, 서버 설정에 따라 명도 대비가 낮은 조합은 거부됩니다. 추천 색상은 /api/images/colors에서 받을 수 있습니다.
 // @Accept json
 // @Produce json
 // @Param ticketDTO body dto.TicketDTO true "생성할 티켓 DTO"
@@ -114,27 +102,12 @@ func (h *TicketHandler) MakeTicket(c *gin.Context) {
 	var req dto.TicketDTO
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"Request Body가 잘못되었습니다",
-		))
+		_ = c.Error(bindingError(err, "request.body_malformed"))
 		return
 	}
 
 	if _, err := time.Parse("2006-01-02", req.Date); err != nil {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"날짜 형식이 잘못되었습니다. YYYY-MM-DD 형식으로 입력해주세요.",
-		))
-		return
-	}
-
-	timePattern := `^(AM|PM)-(?:0[1-9]|1[0-2])-(?:[0-5][0-9])$`
-	if !regexp.MustCompile(timePattern).MatchString(req.Time) {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"시간 형식이 잘못되었습니다. AM/PM-HH-MM 형식으로 입력해주세요.",
-		))
+		_ = c.Error(common.FieldValidationError("date", "format", "validation.date_format"))
 		return
 	}
 
@@ -145,25 +118,16 @@ func (h *TicketHandler) MakeTicket(c *gin.Context) {
 		req.ForegroundColor = "0xff000000"
 	}
 
-	ticket, err := h.ticketUsecase.CreateTicket(userId.(string), &req)
+	ticket, err := h.ticketUsecase.CreateTicket(c.Request.Context(), userId.(string), &req)
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"티켓 생성에 실패했습니다",
-		))
+		_ = c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusCreated, common.Success(
+		c,
 		http.StatusCreated,
-		"티켓이 생성되었습니다",
+		"ticket.created",
 		ticket,
 	))
 }
@@ -171,7 +135,7 @@ func (h *TicketHandler) MakeTicket(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Tags Tickets
 // @Summary 티켓 수정하기
-// @Description 티켓을 수정합니다. presigned-url을 발급받아 이미지 업로드를 완료한 후에, s3 url을 image 값으로 저장합니다.
+// @Description 티켓을 수정합니다. image는 대표 사진을 교체하며, 사진 목록은 /api/tickets/{id}/images 경로로 수정합니다. 본인이 업로드한 이미지만 사용할 수 있습니다. 색상 형식과 명도 대비는 생성할 때와 같이 검사합니다.
 // @Accept json
 // @Produce json
 // @Param id path string true "티켓 ID"
@@ -183,59 +147,34 @@ func (h *TicketHandler) UpdateTicket(c *gin.Context) {
 	id := c.Param("id")
 
 	// 기존 티켓 존재 여부 확인
-	if _, err := h.ticketUsecase.GetTicketByID(userId.(string), id); err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusNotFound, common.Error(
-			http.StatusNotFound,
-			"티켓 조회에 실패했습니다. 아이디를 확인해주세요.",
-		))
+	if _, err := h.ticketUsecase.GetTicketByID(c.Request.Context(), userId.(string), id); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
 	var req dto.TicketUpdateDTO
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"Request Body가 올바르지 않습니다",
-		))
+		_ = c.Error(bindingError(err, "request.body_invalid"))
 		return
 	}
 
 	req.Id = id
-	if err := h.ticketUsecase.UpdateTicket(userId.(string), id, &req); err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"티켓 수정에 실패했습니다",
-		))
+	if err := h.ticketUsecase.UpdateTicket(c.Request.Context(), userId.(string), id, &req); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
 	// 수정된 티켓 정보 조회
-	updatedTicket, err := h.ticketUsecase.GetTicketByID(userId.(string), id)
+	updatedTicket, err := h.ticketUsecase.GetTicketByID(c.Request.Context(), userId.(string), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"수정된 티켓 정보 조회에 실패했습니다",
-		))
+		_ = c.Error(common.ServerError(c, "ticket.reload_failed", err))
 		return
 	}
 
 	c.JSON(http.StatusAccepted, common.Success(
+		c,
 		http.StatusAccepted,
-		"티켓이 수정되었습니다",
+		"ticket.updated",
 		updatedTicket,
 	))
 }
@@ -254,32 +193,179 @@ func (h *TicketHandler) DeleteTicket(c *gin.Context) {
 
 	id := c.Param("id")
 
-	if _, err := h.ticketUsecase.GetTicketByID(userId.(string), id); err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusNotFound, common.Error(
-			http.StatusNotFound,
-			"티켓 조회에 실패했습니다. 아이디를 확인해주세요.",
-		))
+	if err := h.ticketUsecase.DeleteTicket(c.Request.Context(), userId.(string), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"ticket.deleted",
+		id,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Tickets
+// @Summary 티켓 사진 추가하기
+// @Description 티켓에 사진을 추가합니다. 업로드 확인(/api/s3/confirm)을 마친 key를 사용하며, 사진은 10장까지 등록할 수 있습니다. cover가 true이거나 첫 사진이면 대표 사진이 됩니다.
+// @Accept json
+// @Produce json
+// @Param id path string true "티켓 ID"
+// @Param imageInputDTO body dto.ImageInputDTO true "추가할 사진"
+// @Success 201 {object} common.Response{data=[]dto.ImageDTO}
+// @Router /api/tickets/{id}/images [post]
+func (h *TicketHandler) AddTicketImage(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	id := c.Param("id")
+
+	var req dto.ImageInputDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(bindingError(err, "request.body_key_required"))
+		return
+	}
+
+	resp, err := h.ticketUsecase.AddTicketImage(c.Request.Context(), userId.(string), id, &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusCreated, common.Success(
+		c,
+		http.StatusCreated,
+		"gallery.image_added",
+		resp,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Tickets
+// @Summary 티켓 사진 수정하기
+// @Description 사진 설명을 수정하거나 대표 사진으로 지정합니다. caption을 보내지 않으면 설명은 그대로 유지됩니다.
+// @Accept json
+// @Produce json
+// @Param id path string true "티켓 ID"
+// @Param imageId path string true "사진 ID"
+// @Param imageUpdateDTO body dto.ImageUpdateDTO true "수정할 내용"
+// @Success 200 {object} common.Response{data=[]dto.ImageDTO}
+// @Router /api/tickets/{id}/images/{imageId} [patch]
+func (h *TicketHandler) UpdateTicketImage(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	id := c.Param("id")
+	imageId := c.Param("imageId")
+
+	var req dto.ImageUpdateDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(bindingError(err, "request.body_invalid"))
 		return
 	}
 
-	err := h.ticketUsecase.DeleteTicket(id)
+	resp, err := h.ticketUsecase.UpdateTicketImage(c.Request.Context(), userId.(string), id, imageId, &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"티켓 삭제에 실패했습니다",
-		))
+		_ = c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, common.Success(
+		c,
 		http.StatusOK,
-		"티켓이 삭제되었습니다",
-		id,
+		"gallery.image_updated",
+		resp,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Tickets
+// @Summary 티켓 사진 삭제하기
+// @Description 사진을 삭제합니다. 대표 사진을 삭제하면 남은 사진 중 첫 번째 사진이 대표 사진이 됩니다.
+// @Accept json
+// @Produce json
+// @Param id path string true "티켓 ID"
+// @Param imageId path string true "사진 ID"
+// @Success 200 {object} common.Response{data=[]dto.ImageDTO}
+// @Router /api/tickets/{id}/images/{imageId} [delete]
+func (h *TicketHandler) RemoveTicketImage(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	id := c.Param("id")
+	imageId := c.Param("imageId")
+
+	resp, err := h.ticketUsecase.RemoveTicketImage(c.Request.Context(), userId.(string), id, imageId)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"gallery.image_removed",
+		resp,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Tickets
+// @Summary 티켓 사진 순서 변경하기
+// @Description 사진을 imageIds 순서로 정렬합니다. 모든 사진의 ID를 한 번씩 보내야 합니다.
+// @Accept json
+// @Produce json
+// @Param id path string true "티켓 ID"
+// @Param imageOrderDTO body dto.ImageOrderDTO true "사진 ID 순서"
+// @Success 200 {object} common.Response{data=[]dto.ImageDTO}
+// @Router /api/tickets/{id}/images/order [put]
+func (h *TicketHandler) ReorderTicketImages(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	id := c.Param("id")
+
+	var req dto.ImageOrderDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(bindingError(err, "request.body_image_ids_required"))
+		return
+	}
+
+	resp, err := h.ticketUsecase.ReorderTicketImages(c.Request.Context(), userId.(string), id, req.ImageIds)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"gallery.reordered",
+		resp,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Tickets
+// @Summary 사진으로 티켓 초안 만들기
+// @Description 업로드한 종이 티켓 사진에서 글자를 인식해 제목, 장소, 날짜, 시간, 좌석을 채운 티켓 초안을 만듭니다. 티켓은 저장되지 않으며, 사용자가 확인·수정한 뒤 티켓 생성하기로 저장합니다. 좌석은 fields에 요청 언어로 된 "좌석"(Seat) 항목으로 담기고, confidence에는 항목별 인식 신뢰도(0~1, 찾지 못하면 0)가 담깁니다. 서버에서 글자 인식을 사용할 수 없으면 503(UNAVAILABLE)을 반환합니다.
+// @Accept json
+// @Produce json
+// @Param ticketDraftRequestDTO body dto.TicketDraftRequestDTO true "업로드한 티켓 사진 키"
+// @Success 200 {object} common.Response{data=dto.TicketDraftDTO}
+// @Router /api/tickets/draft-from-image [post]
+func (h *TicketHandler) DraftTicketFromImage(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	var req dto.TicketDraftRequestDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(bindingError(err, "request.body_key_required"))
+		return
+	}
+
+	draft, err := h.draftUsecase.DraftFromImage(c.Request.Context(), userId.(string), req.Key)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"ticket.draft_created",
+		draft,
 	))
 }
@@ -6,32 +6,40 @@ import (
 	"github.com/doyeon0307/tickit-backend/common"
 	"github.com/doyeon0307/tickit-backend/domain"
 	"github.com/doyeon0307/tickit-backend/dto"
+	"github.com/doyeon0307/tickit-backend/i18n"
+	"github.com/doyeon0307/tickit-backend/metrics"
 	"github.com/doyeon0307/tickit-backend/service"
 	"github.com/gin-gonic/gin"
 )
 
 type UserHandler struct {
 	userUsecase domain.UserUsecase
+	jwt         *service.JWTManager
 }
 
-func NewUserHandler(rg *gin.RouterGroup, usecase domain.UserUsecase) {
+// NewUserHandler는 로그인 전 요청을 public에, 로그인한 사용자의 요청을 authorized에 등록합니다.
+// authorized는 인증과 사용자별 요청 제한을 이미 적용한 그룹이어야 합니다.
+func NewUserHandler(public, authorized *gin.RouterGroup, usecase domain.UserUsecase, jwt *service.JWTManager) {
 	handler := &UserHandler{
 		userUsecase: usecase,
+		jwt:         jwt,
 	}
 
-	users := rg.Group("/auth")
+	users := public.Group("/auth")
 	{
 		users.POST("/kakao/login", handler.Login)
 		users.POST("/kakao/register", handler.Register)
 		users.POST("/refresh", handler.RefreshToken)
+	}
 
-		authorized := users.Group("")
-		authorized.Use(service.AuthMiddleware())
-		{
-			authorized.DELETE("", handler.Withdraw)
-			authorized.DELETE("/logout", handler.Logout)
-			authorized.GET("", handler.GetProfile)
-		}
+	account := authorized.Group("/auth")
+	{
+		account.DELETE("", handler.Withdraw)
+		account.DELETE("/logout", handler.Logout)
+		account.GET("", handler.GetProfile)
+		account.PUT("/timezone", handler.UpdateTimeZone)
+		account.PUT("/locale", handler.UpdateLocale)
+		account.GET("/usage", handler.GetStorageUsage)
 	}
 }
 
@@ -44,72 +52,45 @@ func NewUserHandler(rg *gin.RouterGroup, usecase domain.UserUsecase) {
 // @Success 200 {object} common.Response{data=dto.TokenResponse} "성공"
 // @Router /api/auth/kakao/login [post]
 func (h *UserHandler) Login(c *gin.Context) {
+	// 오류 응답은 핸들러가 끝난 뒤 ErrorMiddleware가 쓰므로 응답 상태 대신 기록된 오류로 성공 여부를 판단합니다
+	defer func() {
+		metrics.LoginAttempted(metrics.ProviderKakao, len(c.Errors) == 0)
+	}()
+
 	var tokens dto.KakaoTokens
 	if err := c.ShouldBindJSON(&tokens); err != nil {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"Request Body가 올바르지 않습니다. accessToken, refreshToken, idToken이 포함되었는지 확인해주세요.",
-		))
+		_ = c.Error(bindingError(err, "request.body_login_tokens_required"))
 		return
 	}
 
 	oauthId, err := service.GetOAuthIdFromKakao(tokens.IDToken)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, common.Error(
-			http.StatusUnauthorized,
-			"카카오 로그인 인증에 실패했습니다",
-		))
+		_ = c.Error(common.NewError(common.ErrUnauthorized, "auth.kakao_failed", err))
 		return
 	}
 
-	user, err := h.userUsecase.GetUserByOAuthId(oauthId)
+	user, err := h.userUsecase.GetUserByOAuthId(c.Request.Context(), oauthId)
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusNotFound, common.Error(
-			http.StatusNotFound,
-			"가입되지 않은 사용자입니다. 회원가입이 필요합니다.",
-		))
+		_ = c.Error(err)
 		return
 	}
 
 	id := user.Id
-	accessToken, err := service.GenerateAccessToken(id)
+	accessToken, err := h.jwt.GenerateAccessToken(id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"Access Token 생성에 실패했습니다",
-		))
+		_ = c.Error(common.ServerError(c, "auth.access_token_failed", err))
 		return
 	}
 
-	refreshToken, expiryTime, err := service.GenerateRefreshToken(id)
+	refreshToken, expiryTime, err := h.jwt.GenerateRefreshToken(id)
 
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"Refresh Token 생성에 실패했습니다",
-		))
+		_ = c.Error(common.ServerError(c, "auth.refresh_token_failed", err))
 		return
 	}
 
-	if err := h.userUsecase.SaveRefreshToken(id, refreshToken, expiryTime); err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"Refresh Token 저장에 오류가 발생했습니다",
-		))
+	if err := h.userUsecase.SaveRefreshToken(c.Request.Context(), id, refreshToken, expiryTime); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
@@ -118,8 +99,9 @@ func (h *UserHandler) Login(c *gin.Context) {
 		RefreshToken: refreshToken,
 	}
 	c.JSON(http.StatusOK, common.Success(
+		c,
 		http.StatusOK,
-		"로그인에 성공했습니다",
+		"auth.logged_in",
 		resp,
 	))
 }
@@ -135,61 +117,32 @@ func (h *UserHandler) Login(c *gin.Context) {
 func (h *UserHandler) Register(c *gin.Context) {
 	var tokens dto.KakaoTokens
 	if err := c.ShouldBindJSON(&tokens); err != nil {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"Request Body가 올바르지 않습니다. accessToken과 idToken이 포함되었는지 확인해주세요.",
-		))
+		_ = c.Error(bindingError(err, "request.body_register_tokens_required"))
 		return
 	}
 
-	userId, err := h.userUsecase.CreateUser(tokens.IDToken, tokens.AccessToken)
+	userId, err := h.userUsecase.CreateUser(c.Request.Context(), tokens.IDToken, tokens.AccessToken)
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"회원가입 중 오류가 발생했습니다",
-		))
+		_ = c.Error(err)
 		return
 	}
 
 	// 회원가입 성공 시 바로 JWT 토큰 발급
-	accessToken, err := service.GenerateAccessToken(userId)
+	accessToken, err := h.jwt.GenerateAccessToken(userId)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"토큰 생성에 실패했습니다",
-		))
+		_ = c.Error(common.ServerError(c, "auth.token_failed", err))
 		return
 	}
 
-	refreshToken, expiryTime, err := service.GenerateRefreshToken(userId)
+	refreshToken, expiryTime, err := h.jwt.GenerateRefreshToken(userId)
 
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"Refresh Token 생성에 실패했습니다",
-		))
+		_ = c.Error(common.ServerError(c, "auth.refresh_token_failed", err))
 		return
 	}
 
-	if err := h.userUsecase.SaveRefreshToken(userId, refreshToken, expiryTime); err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"Refresh Token 저장에 오류가 발생했습니다",
-		))
+	if err := h.userUsecase.SaveRefreshToken(c.Request.Context(), userId, refreshToken, expiryTime); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
@@ -198,8 +151,9 @@ func (h *UserHandler) Register(c *gin.Context) {
 		RefreshToken: refreshToken,
 	}
 	c.JSON(http.StatusCreated, common.Success(
+		c,
 		http.StatusCreated,
-		"회원가입에 성공했습니다",
+		"auth.registered",
 		resp,
 	))
 }
@@ -215,25 +169,16 @@ func (h *UserHandler) Register(c *gin.Context) {
 func (h *UserHandler) GetProfile(c *gin.Context) {
 	userId, _ := c.Get("userId")
 
-	profile, err := h.userUsecase.GetProfile(userId.(string))
+	profile, err := h.userUsecase.GetProfile(c.Request.Context(), userId.(string))
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"프로필 조회 중 오류가 발생했습니다",
-		))
+		_ = c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusOK, common.Success(
+		c,
 		http.StatusOK,
-		"프로필 조회에 성공했습니다",
+		"user.profile_loaded",
 		profile,
 	))
 }
@@ -249,24 +194,15 @@ func (h *UserHandler) GetProfile(c *gin.Context) {
 func (h *UserHandler) Withdraw(c *gin.Context) {
 	userId, _ := c.Get("userId")
 
-	if err := h.userUsecase.WithdrawUser(userId.(string)); err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"회원 탈퇴 처리 중 오류가 발생했습니다",
-		))
+	if err := h.userUsecase.WithdrawUser(c.Request.Context(), userId.(string)); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusOK, common.Success(
+		c,
 		http.StatusOK,
-		"회원 탈퇴가 완료되었습니다",
+		"user.deleted",
 		nil,
 	))
 }
@@ -282,24 +218,15 @@ func (h *UserHandler) Withdraw(c *gin.Context) {
 func (h *UserHandler) Logout(c *gin.Context) {
 	userId, _ := c.Get("userId")
 
-	if err := h.userUsecase.Logout(userId.(string)); err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"로그아웃 처리 중 오류가 발생했습니다",
-		))
+	if err := h.userUsecase.Logout(c.Request.Context(), userId.(string)); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusOK, common.Success(
+		c,
 		http.StatusOK,
-		"로그아웃이 완료되었습니다",
+		"auth.logged_out",
 		nil,
 	))
 }
@@ -316,59 +243,30 @@ func (h *UserHandler) RefreshToken(c *gin.Context) {
 	var req dto.RefreshTokenRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, common.Error(
-			http.StatusBadRequest,
-			"Request Body가 올바르지 않습니다. refreshToken이 포함되었는지 확인해주세요.",
-		))
+		_ = c.Error(bindingError(err, "request.body_refresh_token_required"))
 		return
 	}
 
-	userId, err := service.ValidateToken(req.RefreshToken)
+	userId, err := h.jwt.ValidateToken(req.RefreshToken)
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusUnauthorized, common.Error(
-			http.StatusUnauthorized,
-			"유효하지 않은 Refresh Token입니다",
-		))
+		_ = c.Error(err)
 		return
 	}
 
-	isValid, err := h.userUsecase.ValidateStoredRefreshToken(userId, req.RefreshToken)
+	isValid, err := h.userUsecase.ValidateStoredRefreshToken(c.Request.Context(), userId, req.RefreshToken)
 	if err != nil {
-		if appErr, ok := err.(*common.AppError); ok {
-			c.JSON(appErr.Code.StatusCode(), common.Error(
-				appErr.Code.StatusCode(),
-				appErr.Message,
-			))
-			return
-		}
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"Refresh Token 검증 도중 오류가 발생했습니다",
-		))
+		_ = c.Error(err)
 		return
 	}
 
 	if !isValid {
-		c.JSON(http.StatusUnauthorized, common.Error(
-			http.StatusUnauthorized,
-			"저장된 Refresh Token과 일치하지 않습니다",
-		))
+		_ = c.Error(common.NewError(common.ErrUnauthorized, "auth.refresh_token_mismatch", nil))
 		return
 	}
 
-	accessToken, err := service.GenerateAccessToken(userId)
+	accessToken, err := h.jwt.GenerateAccessToken(userId)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, common.Error(
-			http.StatusInternalServerError,
-			"새로운 Access Token 생성에 실패했습니다",
-		))
+		_ = c.Error(common.ServerError(c, "auth.access_token_renew_failed", err))
 		return
 	}
 
@@ -377,8 +275,101 @@ func (h *UserHandler) RefreshToken(c *gin.Context) {
 		RefreshToken: req.RefreshToken,
 	}
 	c.JSON(http.StatusOK, common.Success(
+		c,
 		http.StatusOK,
-		"토큰이 성공적으로 갱신되었습니다",
+		"auth.token_refreshed",
 		resp,
 	))
 }
+
+// @Security ApiKeyAuth
+// @Tags Auth
+// @Summary 시간대 설정하기
+// @Description 티켓과 일정의 날짜·시간을 해석할 기본 시간대를 설정합니다. Asia/Seoul과 같은 IANA 시간대 이름을 입력합니다.
+// @Accept json
+// @Produce json
+// @Param timeZone body dto.TimeZoneDTO true "시간대"
+// @Success 200 {object} common.Response
+// @Router /api/auth/timezone [put]
+func (h *UserHandler) UpdateTimeZone(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	var req dto.TimeZoneDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(bindingError(err, "request.body_time_zone_required"))
+		return
+	}
+
+	if err := h.userUsecase.UpdateTimeZone(c.Request.Context(), userId.(string), req.TimeZone); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"user.time_zone_updated",
+		req.TimeZone,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Auth
+// @Summary 언어 설정하기
+// @Description 응답 메시지의 언어를 설정합니다. ko 또는 en을 입력하며, 빈 값을 보내면 설정을 지우고 요청의 Accept-Language를 따릅니다. 설정한 언어는 Accept-Language보다 우선하며, 모든 요청에 반영되기까지 최대 1분이 걸릴 수 있습니다. errorCode는 언어와 관계없이 같습니다.
+// @Accept json
+// @Produce json
+// @Param locale body dto.LocaleDTO true "언어"
+// @Success 200 {object} common.Response
+// @Router /api/auth/locale [put]
+func (h *UserHandler) UpdateLocale(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	var req dto.LocaleDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(bindingError(err, "request.body_invalid"))
+		return
+	}
+
+	locale, err := h.userUsecase.UpdateLocale(c.Request.Context(), userId.(string), req.Locale)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	// 이번 응답부터 새로 설정한 언어를 사용합니다
+	if parsed, ok := i18n.ParseLocale(locale); ok {
+		service.SetLocale(c, parsed)
+	}
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"user.locale_updated",
+		locale,
+	))
+}
+
+// @Security ApiKeyAuth
+// @Tags Auth
+// @Summary 저장 공간 사용량 가져오기
+// @Description 요금제별 저장 공간과 업로드를 확인한 이미지의 사용량을 가져옵니다. 저장 공간이 부족하면 업로드 URL 발급과 업로드 확인이 403(QUOTA_EXCEEDED)으로 거부됩니다.
+// @Accept json
+// @Produce json
+// @Success 200 {object} common.Response{data=dto.StorageUsageDTO}
+// @Router /api/auth/usage [get]
+func (h *UserHandler) GetStorageUsage(c *gin.Context) {
+	userId, _ := c.Get("userId")
+
+	usage, err := h.userUsecase.GetStorageUsage(c.Request.Context(), userId.(string))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Success(
+		c,
+		http.StatusOK,
+		"user.usage_loaded",
+		usage,
+	))
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/metrics"
+	"github.com/doyeon0307/tickit-backend/models"
+	"github.com/doyeon0307/tickit-backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+// fakeUserUsecase는 로그인에 필요한 메서드만 구현합니다. 다른 메서드를 부르면 패닉이 납니다.
+type fakeUserUsecase struct {
+	domain.UserUsecase
+	user *models.User
+	err  error
+}
+
+func (f fakeUserUsecase) GetUserByOAuthId(ctx context.Context, oauthId string) (*models.User, error) {
+	return f.user, f.err
+}
+
+func (f fakeUserUsecase) SaveRefreshToken(ctx context.Context, userId string, refreshToken string, expiryTime time.Time) error {
+	return nil
+}
+
+// loginCount는 지표 응답에서 카카오 로그인 시도 수를 읽습니다
+func loginCount(t *testing.T, result string) float64 {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	prefix := `tickit_logins_total{provider="kakao",result="` + result + `"} `
+	scanner := bufio.NewScanner(rec.Body)
+	for scanner.Scan() {
+		if value, ok := strings.CutPrefix(scanner.Text(), prefix); ok {
+			count, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return count
+		}
+	}
+	return 0
+}
+
+func TestLoginMetric(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"12345"}`))
+	validIDToken := "header." + payload + ".signature"
+
+	tests := []struct {
+		name        string
+		body        string
+		usecase     fakeUserUsecase
+		wantStatus  int
+		wantSuccess bool
+	}{
+		{
+			name:       "본문이 올바르지 않음",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "ID 토큰 형식이 올바르지 않음",
+			body:       `{"accessToken":"a","refreshToken":"r","idToken":"not-a-token"}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "가입하지 않은 사용자",
+			body: `{"accessToken":"a","refreshToken":"r","idToken":"` + validIDToken + `"}`,
+			usecase: fakeUserUsecase{err: &common.AppError{
+				Code: common.ErrNotFound,
+				Key:  "user.not_registered",
+			}},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:        "로그인 성공",
+			body:        `{"accessToken":"a","refreshToken":"r","idToken":"` + validIDToken + `"}`,
+			usecase:     fakeUserUsecase{user: &models.User{Id: "652f1c0e8b3e4a0012345678"}},
+			wantStatus:  http.StatusOK,
+			wantSuccess: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &UserHandler{userUsecase: tt.usecase, jwt: service.NewJWTManager("test-secret")}
+			router := gin.New()
+			router.Use(service.ErrorMiddleware())
+			router.POST("/login", h.Login)
+
+			successes, failures := loginCount(t, "success"), loginCount(t, "failure")
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+
+			wantSuccesses, wantFailures := successes, failures+1
+			if tt.wantSuccess {
+				wantSuccesses, wantFailures = successes+1, failures
+			}
+			if got := loginCount(t, "success"); got != wantSuccesses {
+				t.Errorf("success count = %g, want %g", got, wantSuccesses)
+			}
+			if got := loginCount(t, "failure"); got != wantFailures {
+				t.Errorf("failure count = %g, want %g", got, wantFailures)
+			}
+		})
+	}
+}
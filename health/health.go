@@ -0,0 +1,115 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	StatusOK          = "ok"
+	StatusError       = "error"
+	StatusUnavailable = "unavailable"
+)
+
+// Check는 의존하는 서비스 하나를 확인합니다. ctx의 기한 안에 끝나야 합니다.
+type Check func(ctx context.Context) error
+
+type check struct {
+	name    string
+	timeout time.Duration
+	run     Check
+}
+
+// CheckResult는 확인 하나의 결과입니다
+type CheckResult struct {
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latencyMs"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Report는 준비 상태 확인 결과입니다. 확인이 하나라도 실패하면 Status는 unavailable입니다.
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// Registry는 서버가 요청을 받을 준비가 되었는지 판단하는 확인 목록입니다.
+// 새로운 하위 시스템은 Register로 자기 확인을 추가합니다.
+type Registry struct {
+	mu     sync.RWMutex
+	checks []check
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register는 확인을 추가합니다. 확인은 실행될 때마다 timeout 안에 끝나야 성공으로 봅니다.
+func (r *Registry) Register(name string, timeout time.Duration, run Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checks = append(r.checks, check{name: name, timeout: timeout, run: run})
+}
+
+// Run은 등록된 확인을 동시에 실행합니다
+func (r *Registry) Run(ctx context.Context) *Report {
+	r.mu.RLock()
+	checks := append([]check{}, r.checks...)
+	r.mu.RUnlock()
+
+	report := &Report{
+		Status: StatusOK,
+		Checks: make(map[string]CheckResult, len(checks)),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, c := range checks {
+		wg.Add(1)
+		go func(c check) {
+			defer wg.Done()
+			result := c.execute(ctx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[c.name] = result
+			if result.Status != StatusOK {
+				report.Status = StatusUnavailable
+			}
+		}(c)
+	}
+	wg.Wait()
+
+	return report
+}
+
+func (c check) execute(ctx context.Context) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	// 확인이 ctx를 무시하더라도 기한이 지나면 실패로 처리합니다
+	done := make(chan error, 1)
+	start := time.Now()
+	go func() {
+		done <- c.run(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := CheckResult{
+		Status:    StatusOK,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		result.Status = StatusError
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,96 @@
+// Package i18n은 사용자에게 보여줄 메시지를 요청 언어로 번역합니다.
+// 코드에서는 메시지 대신 ticket.not_found와 같은 키를 사용하고, 응답을 만들 때 키를 번역합니다.
+package i18n
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+type Locale string
+
+const (
+	Korean  Locale = "ko"
+	English Locale = "en"
+	// Default는 요청에서 언어를 알 수 없을 때와 로그에 사용하는 언어입니다
+	Default = Korean
+)
+
+// Supported는 번역을 제공하는 언어입니다. 첫 번째 언어가 기본 언어입니다.
+var Supported = []Locale{Korean, English}
+
+var catalogs = map[Locale]map[string]string{
+	Korean:  korean,
+	English: english,
+}
+
+var matcher = language.NewMatcher([]language.Tag{language.Korean, language.English})
+
+// ParseLocale은 ko, en-US와 같은 언어 태그를 지원하는 언어로 바꿉니다
+func ParseLocale(value string) (Locale, bool) {
+	tag, err := language.Parse(value)
+	if err != nil {
+		return "", false
+	}
+	base, _ := tag.Base()
+	locale := Locale(base.String())
+	if _, ok := catalogs[locale]; !ok {
+		return "", false
+	}
+	return locale, true
+}
+
+// FromAcceptLanguage는 Accept-Language 헤더에서 지원하는 언어 중 가장 알맞은 언어를 고릅니다.
+// 헤더가 없거나 지원하는 언어가 없으면 false를 반환합니다.
+func FromAcceptLanguage(header string) (Locale, bool) {
+	if header == "" {
+		return Default, false
+	}
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil || len(tags) == 0 {
+		return Default, false
+	}
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return Default, false
+	}
+	return Supported[index], true
+}
+
+type contextKey struct{}
+
+// WithLocale은 요청 처리 중 사용할 언어를 ctx에 담습니다
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, contextKey{}, locale)
+}
+
+// FromContext는 ctx에 담긴 언어를 반환합니다. 담긴 언어가 없으면 Default입니다.
+func FromContext(ctx context.Context) Locale {
+	if locale, ok := ctx.Value(contextKey{}).(Locale); ok {
+		return locale
+	}
+	return Default
+}
+
+// Translate는 key의 메시지를 locale로 번역하고 args로 채웁니다.
+// 번역이 없으면 기본 언어의 메시지를, 기본 언어에도 없으면 key를 그대로 반환합니다.
+func Translate(locale Locale, key string, args ...any) string {
+	message, ok := catalogs[locale][key]
+	if !ok {
+		message, ok = catalogs[Default][key]
+	}
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
+
+// T는 ctx의 언어로 key를 번역합니다
+func T(ctx context.Context, key string, args ...any) string {
+	return Translate(FromContext(ctx), key, args...)
+}
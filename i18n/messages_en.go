@@ -0,0 +1,171 @@
+package i18n
+
+// english는 영어 메시지입니다. 서식 지정자는 korean과 같은 인자를 받으며, 어순이 다르면 %[1]s처럼 인자 번호를 지정합니다.
+var english = map[string]string{
+	"auth.access_token_failed":         "Failed to create an access token.",
+	"auth.access_token_renew_failed":   "Failed to create a new access token.",
+	"auth.bearer_missing":              "No token in the header. Use the form Bearer <token>.",
+	"auth.header_missing":              "The Authorization header is missing.",
+	"auth.kakao_failed":                "Kakao login verification failed.",
+	"auth.logged_in":                   "Logged in.",
+	"auth.logged_out":                  "Logged out.",
+	"auth.refresh_token_delete_failed": "Failed to delete the refresh token.",
+	"auth.refresh_token_failed":        "Failed to create a refresh token.",
+	"auth.refresh_token_load_failed":   "Failed to load the refresh token.",
+	"auth.refresh_token_mismatch":      "The refresh token does not match.",
+	"auth.refresh_token_save_failed":   "Failed to save the refresh token.",
+	"auth.registered":                  "Signed up.",
+	"auth.token_expired":               "The token has expired.",
+	"auth.token_failed":                "Failed to create a token.",
+	"auth.token_invalid":               "Invalid token.",
+	"auth.token_refreshed":             "Token refreshed.",
+	"auth.token_verify_failed":         "Token verification failed.",
+	"auth.user_id_invalid":             "The token contains an invalid user ID. Check the token.",
+
+	"common.database_error":  "A database error occurred.",
+	"common.route_not_found": "The requested path was not found.",
+	"common.server_error":    "A server error occurred.",
+
+	"gallery.conflict":        "The photos were changed by another request. Reload and try again.",
+	"gallery.full":            "You can add up to %d photos.",
+	"gallery.image_added":     "Photo added.",
+	"gallery.image_not_found": "Photo not found. Check the ID.",
+	"gallery.image_removed":   "Photo removed.",
+	"gallery.image_updated":   "Photo updated.",
+	"gallery.order_invalid":   "Enter every photo ID exactly once.",
+	"gallery.reordered":       "Photo order changed.",
+
+	"image.colors_not_found":   "No colors were found in the image.",
+	"image.colors_suggested":   "Color suggestions ready.",
+	"image.colors_unsupported": "Colors cannot be analyzed for this image. Only JPEG, PNG and WebP images are supported.",
+	"image.key_required":       "Enter the key of the image to analyze.",
+	"image.not_found":          "The uploaded image was not found.",
+	"image.not_owner":          "You can only use images you uploaded.",
+	"image.too_many_pixels":    "The image resolution is too high. Use an image of %d megapixels or less.",
+
+	"kakao.access_token_user_failed": "Failed to load user information from Kakao with the access token.",
+	"kakao.api_error":                "Kakao API request failed.",
+	"kakao.http_failed":              "The Kakao request failed.",
+	"kakao.id_token_decode_failed":   "Failed to decode the ID token.",
+	"kakao.id_token_format":          "The ID token is malformed.",
+	"kakao.id_token_parse_failed":    "Failed to process the ID token.",
+	"kakao.id_token_user_failed":     "Failed to load user information from Kakao with the ID token.",
+	"kakao.nickname_missing":         "The user's nickname was not found.",
+	"kakao.oauth_id_missing":         "The ID token has no OAuth ID.",
+	"kakao.read_failed":              "Failed to read the Kakao response.",
+	"kakao.request_failed":           "Failed to create the Kakao request.",
+	"kakao.response_invalid":         "Failed to parse the Kakao response.",
+
+	"lottery.already_pending":  "A lottery entry is already awaiting a result. Record its result first.",
+	"lottery.decided":          "Lottery result recorded.",
+	"lottery.entered":          "Lottery entry registered.",
+	"lottery.entry_conflict":   "The schedule changed before the entry was registered. Please try again.",
+	"lottery.entry_status":     "You cannot enter a lottery for a schedule in %s status.",
+	"lottery.listed":           "Loaded lottery entries.",
+	"lottery.no_pending_entry": "There is no lottery entry awaiting a result.",
+	"lottery.outcome_invalid":  "The lottery result must be WON or LOST: %s",
+	"lottery.type_unknown":     "Unknown lottery entry type: %s",
+
+	"request.body_image_ids_required":       "Invalid request body. Make sure imageIds is included.",
+	"request.body_invalid":                  "Invalid request body.",
+	"request.body_key_required":             "Invalid request body. Make sure key is included.",
+	"request.body_login_tokens_required":    "Invalid request body. Make sure accessToken, refreshToken and idToken are included.",
+	"request.body_malformed":                "Malformed request body.",
+	"request.body_refresh_token_required":   "Invalid request body. Make sure refreshToken is included.",
+	"request.body_register_tokens_required": "Invalid request body. Make sure accessToken and idToken are included.",
+	"request.body_time_zone_required":       "Invalid request body. Make sure timeZone is included.",
+	"request.id_invalid":                    "Invalid ID format.",
+	"request.id_required":                   "Enter an ID.",
+	"request.rate_limited":                  "Too many requests. Try again later.",
+	"request.too_large":                     "The request is too large.",
+
+	"schedule.already_ticketed":  "A ticket has already been made from this schedule.",
+	"schedule.created":           "Schedule created.",
+	"schedule.delete_not_found":  "The schedule does not exist or you cannot delete it.",
+	"schedule.deleted":           "Schedule deleted.",
+	"schedule.duration_negative": "Duration must be 0 minutes or more.",
+	"schedule.listed":            "Loaded schedules.",
+	"schedule.loaded":            "Loaded the schedule.",
+	"schedule.not_found":         "Schedule not found. Check the ID.",
+	"schedule.overlap_warning":   "Overlaps with the schedule '%s'.",
+	"schedule.overlaps_listed":   "Loaded overlapping schedules.",
+	"schedule.status_changed":    "Schedule status changed.",
+	"schedule.status_conflict":   "The schedule status has already changed. Try again.",
+	"schedule.status_transition": "A schedule in %s status cannot change to %s.",
+	"schedule.status_unknown":    "Unknown schedule status: %s",
+	"schedule.ticketable_listed": "Loaded schedules that can become tickets.",
+	"schedule.update_not_found":  "The schedule does not exist or you cannot edit it.",
+	"schedule.updated":           "Schedule updated.",
+
+	"storage.header_mismatch": "Content-Type or Content-Length does not match the issued URL.",
+	"storage.image_not_found": "Image not found.",
+	"storage.upload_failed":   "Upload failed.",
+	"storage.uploaded":        "Upload succeeded.",
+	"storage.url_invalid":     "The URL is invalid or has expired.",
+
+	"ticket.background_color_format": "Invalid background color. Use 0xAARRGGBB or #RRGGBB.",
+	"ticket.color_contrast":          "The background and text colors are too similar to read (%.2f:1, minimum %.1f:1).",
+	"ticket.created":                 "Ticket created.",
+	"ticket.delete_not_found":        "The ticket does not exist and cannot be deleted.",
+	"ticket.deleted":                 "Ticket deleted.",
+	"ticket.draft_convert_failed":    "Failed to convert the image.",
+	"ticket.draft_created":           "Ticket draft created.",
+	"ticket.draft_image_unsupported": "Text cannot be read from this image. Only JPEG, PNG and WebP images are supported.",
+	"ticket.draft_ocr_failed":        "Text recognition failed.",
+	"ticket.draft_seat":              "Seat",
+	"ticket.draft_unavailable":       "Creating tickets from photos is not available right now.",
+	"ticket.foreground_color_format": "Invalid text color. Use 0xAARRGGBB or #RRGGBB.",
+	"ticket.image_required":          "Add at least one ticket image.",
+	"ticket.listed":                  "Loaded tickets.",
+	"ticket.loaded":                  "Loaded the ticket.",
+	"ticket.not_found":               "Ticket not found. Check the ID.",
+	"ticket.reload_failed":           "Failed to load the updated ticket.",
+	"ticket.schedule_not_attended":   "Only attended schedules can become tickets.",
+	"ticket.update_not_found":        "The ticket does not exist and cannot be edited.",
+	"ticket.updated":                 "Ticket updated.",
+
+	"upload.confirmed":            "Upload confirmed.",
+	"upload.empty":                "Images must be at least 1 byte.",
+	"upload.image_field_required": "Put an image file in the image field.",
+	"upload.image_uploaded":       "Image uploaded.",
+	"upload.multipart_invalid":    "The multipart/form-data request is malformed.",
+	"upload.multipart_required":   "Send the image in the image field of a multipart/form-data request.",
+	"upload.not_confirmed":        "This image has not been confirmed.",
+	"upload.not_found":            "The uploaded image was not found. Make sure the upload finished.",
+	"upload.not_image":            "This is not a valid image file. Upload it again.",
+	"upload.not_supported_image":  "This is not a valid image file. Only JPEG, PNG, WebP and HEIC images can be uploaded.",
+	"upload.quota_exceeded":       "Not enough storage. You are using %.1[3]fMB of %.1[2]fMB on the %[1]s plan.",
+	"upload.read_failed":          "Failed to read the uploaded image.",
+	"upload.receive_failed":       "An error occurred while receiving the image. Upload it again.",
+	"upload.save_failed":          "Failed to save the image.",
+	"upload.size_required":        "Enter the image size in bytes.",
+	"upload.too_large":            "Images must be %dMB or smaller.",
+	"upload.type_unsupported":     "Unsupported image type. Only JPEG, PNG, WebP and HEIC images can be uploaded.",
+	"upload.url_failed":           "Failed to create the upload URL.",
+	"upload.url_issued":           "Upload URL issued.",
+
+	"user.already_exists":        "This user already exists. Try logging in.",
+	"user.delete_failed":         "Failed to delete the user.",
+	"user.delete_not_found":      "The user does not exist and cannot be deleted.",
+	"user.deleted":               "Your account has been deleted.",
+	"user.load_failed":           "Failed to load the user.",
+	"user.locale_save_failed":    "Failed to save the language.",
+	"user.locale_updated":        "Language updated.",
+	"user.not_found":             "User not found. Check the token.",
+	"user.not_registered":        "User not found.",
+	"user.profile_loaded":        "Loaded the profile.",
+	"user.time_zone_save_failed": "Failed to save the time zone.",
+	"user.time_zone_updated":     "Time zone updated.",
+	"user.usage_loaded":          "Loaded storage usage.",
+
+	"validation.date_format":        "Invalid date format. Use YYYY-MM-DD.",
+	"validation.end_date_format":    "Invalid end date format. Use YYYY-MM-DD.",
+	"validation.invalid":            "This value is invalid.",
+	"validation.locale":             "Unsupported language. Use ko or en.",
+	"validation.required":           "This field is required.",
+	"validation.result_date_format": "Invalid result date format. Use YYYY-MM-DD.",
+	"validation.start_date_format":  "Invalid start date format. Use YYYY-MM-DD.",
+	"validation.time_format":        "Invalid time format (%s). Use AM/PM-HH-MM, HH:mm or RFC 3339.",
+	"validation.time_zone":          "Unknown time zone. Use an IANA time zone name such as Asia/Seoul.",
+	"validation.type":               "This value has the wrong type.",
+}
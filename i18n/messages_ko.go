@@ -0,0 +1,171 @@
+package i18n
+
+// korean은 기본 언어 메시지입니다. 키를 추가할 때는 english에도 같은 키를 추가합니다.
+var korean = map[string]string{
+	"auth.access_token_failed":         "Access Token 생성에 실패했습니다",
+	"auth.access_token_renew_failed":   "새로운 Access Token 생성에 실패했습니다",
+	"auth.bearer_missing":              "헤더에서 토큰을 찾을 수 없습니다. Bearer + 토큰 형태로 입력해주세요.",
+	"auth.header_missing":              "Authorization 헤더를 찾을 수 없습니다",
+	"auth.kakao_failed":                "카카오 로그인 인증에 실패했습니다",
+	"auth.logged_in":                   "로그인에 성공했습니다",
+	"auth.logged_out":                  "로그아웃이 완료되었습니다",
+	"auth.refresh_token_delete_failed": "Refresh Token 삭제에 실패했습니다",
+	"auth.refresh_token_failed":        "Refresh Token 생성에 실패했습니다",
+	"auth.refresh_token_load_failed":   "Refresh Token 조회에 실패했습니다",
+	"auth.refresh_token_mismatch":      "저장된 Refresh Token과 일치하지 않습니다",
+	"auth.refresh_token_save_failed":   "Refresh Token 저장에 실패했습니다",
+	"auth.registered":                  "회원가입에 성공했습니다",
+	"auth.token_expired":               "토큰이 만료되었습니다",
+	"auth.token_failed":                "토큰 생성에 실패했습니다",
+	"auth.token_invalid":               "유효하지 않은 토큰입니다",
+	"auth.token_refreshed":             "토큰이 성공적으로 갱신되었습니다",
+	"auth.token_verify_failed":         "토큰 검증에 실패했습니다",
+	"auth.user_id_invalid":             "잘못된 아이디가 추출되었습니다. 토큰을 확인해주세요.",
+
+	"common.database_error":  "데이터베이스 오류가 발생했습니다",
+	"common.route_not_found": "요청한 경로를 찾을 수 없습니다",
+	"common.server_error":    "서버 오류가 발생했습니다",
+
+	"gallery.conflict":        "다른 요청이 먼저 사진 목록을 바꾸었습니다. 다시 불러온 뒤 시도해주세요.",
+	"gallery.full":            "사진은 %d장까지 등록할 수 있습니다",
+	"gallery.image_added":     "사진이 추가되었습니다",
+	"gallery.image_not_found": "사진이 존재하지 않습니다. 아이디를 확인해주세요.",
+	"gallery.image_removed":   "사진이 삭제되었습니다",
+	"gallery.image_updated":   "사진이 수정되었습니다",
+	"gallery.order_invalid":   "모든 사진의 아이디를 한 번씩 입력해주세요",
+	"gallery.reordered":       "사진 순서가 변경되었습니다",
+
+	"image.colors_not_found":   "이미지에서 색상을 찾지 못했습니다",
+	"image.colors_suggested":   "색상 추천에 성공했습니다",
+	"image.colors_unsupported": "색상을 분석할 수 없는 이미지입니다. JPEG, PNG, WebP 이미지만 분석할 수 있습니다.",
+	"image.key_required":       "분석할 이미지 키를 입력해주세요",
+	"image.not_found":          "업로드된 이미지를 찾을 수 없습니다",
+	"image.not_owner":          "본인이 업로드한 이미지만 사용할 수 있습니다",
+	"image.too_many_pixels":    "이미지 해상도가 너무 큽니다. %d메가픽셀 이하의 이미지를 사용해주세요.",
+
+	"kakao.access_token_user_failed": "Access Token: 카카오로부터 사용자 정보를 불러오는데 실패했습니다",
+	"kakao.api_error":                "카카오 API 요청이 실패했습니다",
+	"kakao.http_failed":              "HTTP 요청 실패",
+	"kakao.id_token_decode_failed":   "ID Token 디코딩에 실패했습니다",
+	"kakao.id_token_format":          "ID Token의 형식이 잘못되었습니다",
+	"kakao.id_token_parse_failed":    "ID Token 처리에 실패했습니다",
+	"kakao.id_token_user_failed":     "ID Token: 카카오로부터 사용자 정보를 불러오는데 실패했습니다",
+	"kakao.nickname_missing":         "사용자 닉네임을 찾을 수 없습니다",
+	"kakao.oauth_id_missing":         "ID Token에서 OAuthId를 찾을 수 없습니다",
+	"kakao.read_failed":              "응답 바디 읽기 실패",
+	"kakao.request_failed":           "Request 생성 실패",
+	"kakao.response_invalid":         "카카오 응답 파싱 실패",
+
+	"lottery.already_pending":  "결과를 기다리는 응모가 이미 있습니다. 결과를 먼저 입력해주세요.",
+	"lottery.decided":          "응모 결과가 입력되었습니다",
+	"lottery.entered":          "응모가 등록되었습니다",
+	"lottery.entry_conflict":   "일정이 변경되어 응모를 등록하지 못했습니다. 다시 시도해주세요.",
+	"lottery.entry_status":     "%s 상태의 일정에는 응모를 등록할 수 없습니다",
+	"lottery.listed":           "응모 목록 불러오기에 성공했습니다",
+	"lottery.no_pending_entry": "결과를 기다리는 응모 기록이 없습니다",
+	"lottery.outcome_invalid":  "응모 결과는 WON 또는 LOST만 입력할 수 있습니다: %s",
+	"lottery.type_unknown":     "알 수 없는 응모 종류입니다: %s",
+
+	"request.body_image_ids_required":       "Request Body가 올바르지 않습니다. imageIds가 포함되었는지 확인해주세요.",
+	"request.body_invalid":                  "Request Body가 올바르지 않습니다",
+	"request.body_key_required":             "Request Body가 올바르지 않습니다. key가 포함되었는지 확인해주세요.",
+	"request.body_login_tokens_required":    "Request Body가 올바르지 않습니다. accessToken, refreshToken, idToken이 포함되었는지 확인해주세요.",
+	"request.body_malformed":                "Request Body가 잘못되었습니다",
+	"request.body_refresh_token_required":   "Request Body가 올바르지 않습니다. refreshToken이 포함되었는지 확인해주세요.",
+	"request.body_register_tokens_required": "Request Body가 올바르지 않습니다. accessToken과 idToken이 포함되었는지 확인해주세요.",
+	"request.body_time_zone_required":       "Request Body가 올바르지 않습니다. timeZone이 포함되었는지 확인해주세요.",
+	"request.id_invalid":                    "아이디 형식이 잘못되었습니다",
+	"request.id_required":                   "아이디를 입력해주세요",
+	"request.rate_limited":                  "요청이 너무 많습니다. 잠시 후 다시 시도해주세요.",
+	"request.too_large":                     "요청 크기가 너무 큽니다",
+
+	"schedule.already_ticketed":  "이미 티켓으로 만들어진 일정입니다",
+	"schedule.created":           "일정 생성에 성공했습니다",
+	"schedule.delete_not_found":  "존재하지 않는 일정이거나 삭제 권한이 없습니다",
+	"schedule.deleted":           "일정이 삭제되었습니다",
+	"schedule.duration_negative": "소요 시간은 0분 이상이어야 합니다",
+	"schedule.listed":            "일정 목록 불러오기에 성공했습니다",
+	"schedule.loaded":            "세부 일정 조회에 성공했습니다",
+	"schedule.not_found":         "일정이 존재하지 않습니다. 아이디를 확인해주세요.",
+	"schedule.overlap_warning":   "'%s' 일정과 시간이 겹칩니다",
+	"schedule.overlaps_listed":   "겹치는 일정 목록 불러오기에 성공했습니다",
+	"schedule.status_changed":    "일정 상태가 변경되었습니다",
+	"schedule.status_conflict":   "일정 상태가 이미 변경되었습니다. 다시 시도해주세요.",
+	"schedule.status_transition": "%s 상태의 일정은 %s 상태로 변경할 수 없습니다",
+	"schedule.status_unknown":    "알 수 없는 일정 상태입니다: %s",
+	"schedule.ticketable_listed": "티켓으로 만들 수 있는 일정 목록 불러오기에 성공했습니다",
+	"schedule.update_not_found":  "존재하지 않는 일정이거나 수정 권한이 없습니다",
+	"schedule.updated":           "일정이 수정되었습니다",
+
+	"storage.header_mismatch": "Content-Type 또는 Content-Length가 발급된 URL과 다릅니다",
+	"storage.image_not_found": "이미지를 찾을 수 없습니다",
+	"storage.upload_failed":   "업로드에 실패했습니다",
+	"storage.uploaded":        "업로드에 성공했습니다",
+	"storage.url_invalid":     "유효하지 않거나 만료된 URL입니다",
+
+	"ticket.background_color_format": "배경색 형식이 잘못되었습니다. 0xAARRGGBB 또는 #RRGGBB 형식으로 입력해주세요.",
+	"ticket.color_contrast":          "배경색과 글자색의 대비가 너무 낮아 글자를 읽기 어렵습니다 (%.2f:1, 최소 %.1f:1)",
+	"ticket.created":                 "티켓이 생성되었습니다",
+	"ticket.delete_not_found":        "존재하지 않는 티켓은 삭제할 수 없습니다",
+	"ticket.deleted":                 "티켓이 삭제되었습니다",
+	"ticket.draft_convert_failed":    "이미지 변환에 실패했습니다",
+	"ticket.draft_created":           "티켓 초안을 만들었습니다",
+	"ticket.draft_image_unsupported": "글자를 인식할 수 없는 이미지입니다. JPEG, PNG, WebP 이미지만 인식할 수 있습니다.",
+	"ticket.draft_ocr_failed":        "글자 인식에 실패했습니다",
+	"ticket.draft_seat":              "좌석",
+	"ticket.draft_unavailable":       "사진으로 티켓을 만드는 기능을 지금은 사용할 수 없습니다",
+	"ticket.foreground_color_format": "글자색 형식이 잘못되었습니다. 0xAARRGGBB 또는 #RRGGBB 형식으로 입력해주세요.",
+	"ticket.image_required":          "티켓 이미지를 하나 이상 등록해주세요",
+	"ticket.listed":                  "티켓 목록 불러오기에 성공했습니다",
+	"ticket.loaded":                  "티켓 불러오기에 성공했습니다",
+	"ticket.not_found":               "티켓이 존재하지 않습니다. 아이디를 확인해주세요.",
+	"ticket.reload_failed":           "수정된 티켓 정보 조회에 실패했습니다",
+	"ticket.schedule_not_attended":   "관람을 마친 일정만 티켓으로 만들 수 있습니다",
+	"ticket.update_not_found":        "존재하지 않는 티켓은 수정할 수 없습니다",
+	"ticket.updated":                 "티켓이 수정되었습니다",
+
+	"upload.confirmed":            "업로드가 확인되었습니다",
+	"upload.empty":                "이미지 크기는 1바이트 이상이어야 합니다",
+	"upload.image_field_required": "image 필드에 이미지 파일을 담아주세요",
+	"upload.image_uploaded":       "이미지 업로드에 성공했습니다",
+	"upload.multipart_invalid":    "multipart/form-data 형식이 올바르지 않습니다",
+	"upload.multipart_required":   "multipart/form-data 형식으로 image 필드에 이미지를 담아주세요",
+	"upload.not_confirmed":        "확인되지 않은 이미지입니다",
+	"upload.not_found":            "업로드된 이미지를 찾을 수 없습니다. 업로드를 완료했는지 확인해주세요.",
+	"upload.not_image":            "올바른 이미지 파일이 아닙니다. 다시 업로드해주세요.",
+	"upload.not_supported_image":  "올바른 이미지 파일이 아닙니다. JPEG, PNG, WebP, HEIC 이미지만 업로드할 수 있습니다.",
+	"upload.quota_exceeded":       "저장 공간이 부족합니다. %s 요금제에서 %.1fMB 중 %.1fMB를 사용하고 있습니다.",
+	"upload.read_failed":          "업로드된 이미지를 읽지 못했습니다",
+	"upload.receive_failed":       "이미지를 받는 중 오류가 발생했습니다. 다시 업로드해주세요.",
+	"upload.save_failed":          "이미지 저장에 실패했습니다",
+	"upload.size_required":        "이미지 크기를 바이트 단위로 입력해주세요",
+	"upload.too_large":            "이미지 크기는 %dMB 이하여야 합니다",
+	"upload.type_unsupported":     "지원하지 않는 이미지 형식입니다. JPEG, PNG, WebP, HEIC 이미지만 업로드할 수 있습니다.",
+	"upload.url_failed":           "URL 생성에 실패했습니다",
+	"upload.url_issued":           "URL 생성에 성공했습니다",
+
+	"user.already_exists":        "이미 존재하는 사용자입니다. 로그인을 시도해주세요.",
+	"user.delete_failed":         "사용자 삭제에 실패했습니다",
+	"user.delete_not_found":      "존재하지 않는 사용자는 삭제할 수 없습니다",
+	"user.deleted":               "회원 탈퇴가 완료되었습니다",
+	"user.load_failed":           "사용자 조회에 실패했습니다",
+	"user.locale_save_failed":    "언어 저장에 실패했습니다",
+	"user.locale_updated":        "언어가 설정되었습니다",
+	"user.not_found":             "사용자가 존재하지 않습니다. 토큰을 확인해주세요.",
+	"user.not_registered":        "사용자를 찾을 수 없습니다",
+	"user.profile_loaded":        "프로필 조회에 성공했습니다",
+	"user.time_zone_save_failed": "시간대 저장에 실패했습니다",
+	"user.time_zone_updated":     "시간대가 설정되었습니다",
+	"user.usage_loaded":          "사용량 조회에 성공했습니다",
+
+	"validation.date_format":        "날짜 형식이 잘못되었습니다. YYYY-MM-DD 형식으로 입력해주세요.",
+	"validation.end_date_format":    "종료 날짜 형식이 잘못되었습니다. YYYY-MM-DD 형식으로 입력해주세요.",
+	"validation.invalid":            "올바르지 않은 값입니다",
+	"validation.locale":             "지원하지 않는 언어입니다. ko 또는 en을 입력해주세요.",
+	"validation.required":           "필수 항목입니다",
+	"validation.result_date_format": "결과 발표일 형식이 잘못되었습니다. YYYY-MM-DD 형식으로 입력해주세요.",
+	"validation.start_date_format":  "시작 날짜 형식이 잘못되었습니다. YYYY-MM-DD 형식으로 입력해주세요.",
+	"validation.time_format":        "시간 형식이 잘못되었습니다(%s). AM/PM-HH-MM, HH:mm 또는 RFC 3339 형식으로 입력해주세요.",
+	"validation.time_zone":          "알 수 없는 시간대입니다. Asia/Seoul과 같은 IANA 시간대 이름을 입력해주세요.",
+	"validation.type":               "값의 형식이 올바르지 않습니다",
+}
@@ -1,13 +1,49 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	_ "time/tzdata"
 
 	"github.com/doyeon0307/tickit-backend/config"
+	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/health"
+	"github.com/doyeon0307/tickit-backend/logging"
+	"github.com/doyeon0307/tickit-backend/metrics"
+	"github.com/doyeon0307/tickit-backend/ocr"
+	"github.com/doyeon0307/tickit-backend/ratelimit"
 	"github.com/doyeon0307/tickit-backend/repository"
 	"github.com/doyeon0307/tickit-backend/routes"
+	"github.com/doyeon0307/tickit-backend/service"
+	"github.com/doyeon0307/tickit-backend/storage"
+	"github.com/doyeon0307/tickit-backend/tracing"
 	"github.com/doyeon0307/tickit-backend/usecase"
+	"github.com/doyeon0307/tickit-backend/utils"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+const (
+	thumbnailWorkers   = 4
+	thumbnailQueueSize = 256
+
+	// 준비 상태 확인마다 허용하는 시간입니다
+	mongoCheckTimeout   = 2 * time.Second
+	storageCheckTimeout = 3 * time.Second
+
+	// 지표를 수집할 때 문서 수를 세는 데 허용하는 시간입니다
+	countGaugeTimeout = 2 * time.Second
+
+	// 시작할 때 기존 일정의 시작·종료 시각을 채우는 데 허용하는 시간입니다
+	backfillTimeout = time.Minute
 )
 
 // @title Tickit!
@@ -19,41 +55,275 @@ import (
 // @name Authorization
 
 func main() {
-	var awsAccessKey = os.Getenv("AWS_ACCESS_KEY")
-	var awsSecretKey = os.Getenv("AWS_SECRET_KEY")
-
-	s3Config, err := config.NewS3Config(
-		awsAccessKey,
-		awsSecretKey,
-		"us-east-1",
-		"tickit-s3-bucket",
-	)
+	cfg, err := config.Load(os.Args[1:])
 	if err != nil {
-		log.Fatal("S3 연결에 실패했습니다")
+		fatal("설정을 읽지 못했습니다", err)
 	}
+	slog.SetDefault(logging.New(os.Stdout, cfg.Log.Level))
+	slog.Info("설정을 읽었습니다", "config", cfg.Redacted())
 
-	db, err := config.ConnectDB()
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing.Exporter, cfg.Tracing.OTLPEndpoint, cfg.Tracing.SampleRatio)
 	if err != nil {
-		log.Fatal("데이터베이스 연결에 실패했습니다")
+		fatal("트레이싱 설정에 실패했습니다", err)
 	}
 
-	ticketRepo := repository.NewTicketRepository(db)
-	ticketUsecase := usecase.NewTicketUseCase(ticketRepo)
+	objectStorage, localStorage, err := newObjectStorage(cfg.Storage)
+	if err != nil {
+		fatal("저장소 연결에 실패했습니다", err)
+	}
+	utils.SetImageHosts(cfg.Storage.ImageHosts()...)
 
-	scheduleRepo := repository.NewScheduleRepository(db)
-	scheduleUsecase := usecase.NewScheduleUsecase(scheduleRepo)
+	db, err := config.ConnectDB(cfg.Mongo)
+	if err != nil {
+		fatal("데이터베이스 연결에 실패했습니다", err)
+	}
+
+	uploadRepo := repository.NewUploadRepository(db)
+	thumbnailWorker := service.NewThumbnailWorker(objectStorage, uploadRepo, thumbnailWorkers, thumbnailQueueSize)
+	imageSigner := usecase.NewImageURLSigner(objectStorage, uploadRepo)
+
+	jwt := service.NewJWTManager(cfg.Auth.JWTSecret)
 
 	userRepo := repository.NewUserRepository(db)
-	userUsecase := usecase.NewUserUsecase(userRepo)
+	storageQuota := usecase.NewStorageQuota(userRepo, uploadRepo, cfg.StorageQuotas())
+	userUsecase := usecase.NewUserUsecase(userRepo, storageQuota)
+
+	scheduleRepo := repository.NewScheduleRepository(db)
+	scheduleUsecase := usecase.NewScheduleUsecase(scheduleRepo, userRepo, uploadRepo, imageSigner)
+	backfillScheduleIntervals(scheduleUsecase)
+
+	ticketRepo := repository.NewTicketRepository(db)
+	ticketUsecase := usecase.NewTicketUseCase(ticketRepo, scheduleRepo, userRepo, uploadRepo, imageSigner, cfg.Ticket.MinContrast)
+
+	ticketDraftUsecase := usecase.NewTicketDraftUsecase(objectStorage, uploadRepo, newTextRecognizer(cfg.OCR))
+
+	uploadUsecase := usecase.NewUploadUsecase(objectStorage, uploadRepo, thumbnailWorker, storageQuota)
+
+	imageGC := service.NewImageGC(
+		objectStorage,
+		ticketRepo,
+		scheduleRepo,
+		uploadRepo,
+		userRepo,
+		cfg.ImageGC.Interval,
+		cfg.ImageGC.GracePeriod,
+		cfg.ImageGC.DryRun,
+	)
+	imageGC.Start()
+
+	readiness := health.NewRegistry()
+	readiness.Register("mongo", mongoCheckTimeout, func(ctx context.Context) error {
+		return db.Client().Ping(ctx, readpref.Primary())
+	})
+	readiness.Register("storage", storageCheckTimeout, objectStorage.Ping)
+
+	metrics.RegisterGauge("tickets", "저장된 티켓 수 (추정치)", countGauge(ticketRepo.Count))
+	metrics.RegisterGauge("users", "가입한 사용자 수 (추정치)", countGauge(userRepo.Count))
+
+	rateLimit, err := newRateLimitStore(cfg.RateLimit)
+	if err != nil {
+		fatal("요청 제한 저장소 설정에 실패했습니다", err)
+	}
 
 	handlers := routes.HandlerContainer{
-		TicketUsecase:   ticketUsecase,
-		ScheduleUsecase: scheduleUsecase,
-		UserUsecase:     userUsecase,
-		S3Config:        *s3Config,
+		TicketUsecase:      ticketUsecase,
+		TicketDraftUsecase: ticketDraftUsecase,
+		ScheduleUsecase:    scheduleUsecase,
+		UserUsecase:        userUsecase,
+		UploadUsecase:      uploadUsecase,
+		JWT:                jwt,
+		Health:             readiness,
+		LocalStorage:       localStorage,
+		RateLimit:          rateLimit,
+		TrustedProxies:     cfg.Server.TrustedProxies,
 	}
 
 	router := routes.SetupRouter(handlers)
+	metricsServer := newMetricsServer(cfg.Server)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.Server.Port),
+		Handler:           router,
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		WriteTimeout:      cfg.Server.WriteTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serverErr := make(chan error, 2)
+	go func() {
+		slog.Info("서버를 시작합니다", "addr", server.Addr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serverErr <- err
+		}
+	}()
+	if metricsServer != nil {
+		go func() {
+			slog.Info("지표 서버를 시작합니다", "addr", metricsServer.Addr)
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				serverErr <- fmt.Errorf("지표 서버: %w", err)
+			}
+		}()
+	}
+
+	select {
+	case err := <-serverErr:
+		slog.Error("서버 실행에 실패했습니다", "error", err)
+	case <-ctx.Done():
+		slog.Info("종료 신호를 받았습니다. 처리 중인 요청과 작업을 마무리합니다", "timeout", cfg.Server.ShutdownTimeout.String())
+	}
+	stop()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	shutdown(shutdownCtx, server, metricsServer, imageGC, thumbnailWorker, db.Client(), shutdownTracing)
+}
+
+// newMetricsServer는 Prometheus 지표만 제공하는 내부 서버입니다. METRICS_ADDR가 비어 있으면 nil입니다.
+func newMetricsServer(cfg config.ServerConfig) *http.Server {
+	if cfg.MetricsAddr == "" {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	return &http.Server{
+		Addr:              cfg.MetricsAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+	}
+}
+
+// shutdown은 새 요청을 받지 않고 처리 중인 요청이 끝나기를 기다린 뒤,
+// 백그라운드 작업을 멈추고 MongoDB 연결을 끊은 다음 남은 스팬을 내보냅니다. 각 단계는 ctx의 기한 안에서만 기다립니다.
+// 지표 서버는 종료 중에도 수집할 수 있도록 마지막까지 남겨 둡니다.
+func shutdown(ctx context.Context, server, metricsServer *http.Server, imageGC *service.ImageGC, thumbnailWorker *service.ThumbnailWorker, client *mongo.Client, shutdownTracing func(context.Context) error) {
+	if err := server.Shutdown(ctx); err != nil {
+		slog.Warn("처리 중인 요청을 모두 마치지 못했습니다", "error", err)
+	}
+
+	// 썸네일 작업은 MongoDB에 결과를 저장하므로 연결을 끊기 전에 멈춥니다
+	waitUntil(ctx, "이미지 정리 작업", imageGC.Stop)
+	waitUntil(ctx, "썸네일 작업", thumbnailWorker.Stop)
+
+	if err := client.Disconnect(ctx); err != nil {
+		slog.Warn("데이터베이스 연결 종료에 실패했습니다", "error", err)
+	}
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Warn("남은 스팬을 내보내지 못했습니다", "error", err)
+	}
+	if metricsServer != nil {
+		if err := metricsServer.Shutdown(ctx); err != nil {
+			slog.Warn("지표 서버를 종료하지 못했습니다", "error", err)
+		}
+	}
+	slog.Info("서버를 종료했습니다")
+}
+
+// backfillScheduleIntervals는 시작·종료 시각 없이 저장된 기존 일정을 채웁니다.
+// 채우지 못해도 서버는 시작하며, 남은 일정은 다음 시작 때 다시 채웁니다.
+func backfillScheduleIntervals(scheduleUsecase domain.ScheduleUsecase) {
+	ctx, cancel := context.WithTimeout(context.Background(), backfillTimeout)
+	defer cancel()
+
+	filled, err := scheduleUsecase.BackfillIntervals(ctx)
+	if err != nil {
+		slog.Warn("기존 일정의 시각을 모두 채우지 못했습니다", "filled", filled, "error", err)
+		return
+	}
+	if filled > 0 {
+		slog.Info("기존 일정의 시각을 채웠습니다", "filled", filled)
+	}
+}
+
+// countGauge는 지표를 수집할 때마다 count를 호출합니다. 세지 못하면 NaN을 기록합니다.
+func countGauge(count func(ctx context.Context) (int64, error)) func() float64 {
+	return func() float64 {
+		ctx, cancel := context.WithTimeout(context.Background(), countGaugeTimeout)
+		defer cancel()
 
-	router.Run(":7000")
+		n, err := count(ctx)
+		if err != nil {
+			return math.NaN()
+		}
+		return float64(n)
+	}
+}
+
+// fatal은 오류를 기록하고 서버를 시작하지 않고 끝냅니다
+func fatal(message string, err error) {
+	slog.Error(message, "error", err)
+	os.Exit(1)
+}
+
+// waitUntil은 stop이 끝나거나 ctx의 기한이 지날 때까지 기다립니다
+func waitUntil(ctx context.Context, name string, stop func()) {
+	done := make(chan struct{})
+	go func() {
+		stop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		slog.Warn("종료 기한 안에 끝나지 않았습니다", "task", name)
+	}
+}
+
+// newObjectStorage는 설정된 저장소를 생성합니다.
+// 로컬 저장소를 사용하면 서명된 URL을 처리할 수 있도록 LocalStorage도 함께 반환합니다.
+func newObjectStorage(cfg config.StorageConfig) (domain.ObjectStorage, *storage.LocalStorage, error) {
+	switch cfg.Backend {
+	case config.StorageS3:
+		s3Storage, err := storage.NewS3Storage(
+			cfg.S3.AccessKey,
+			cfg.S3.SecretKey,
+			cfg.S3.Region,
+			cfg.S3.Bucket,
+		)
+		return s3Storage, nil, err
+	case config.StorageLocal:
+		localStorage, err := storage.NewLocalStorage(
+			cfg.Local.Dir,
+			cfg.Local.URL,
+			[]byte(cfg.Local.SigningKey),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		return localStorage, localStorage, nil
+	}
+	return nil, nil, fmt.Errorf("지원하지 않는 저장소입니다: %s", cfg.Backend)
+}
+
+// newRateLimitStore는 설정된 요청 제한 저장소를 생성합니다. none이면 nil을 반환하며 요청을 제한하지 않습니다.
+// Redis에 연결할 수 없는 동안에는 요청을 제한하지 않고 통과시킵니다.
+func newRateLimitStore(cfg config.RateLimitConfig) (ratelimit.Store, error) {
+	switch cfg.Store {
+	case config.RateLimitMemory:
+		return ratelimit.NewMemoryStore(), nil
+	case config.RateLimitRedis:
+		return ratelimit.NewRedisStore(cfg.RedisURL)
+	case config.RateLimitNone:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("지원하지 않는 요청 제한 저장소입니다: %s", cfg.Store)
+}
+
+// newTextRecognizer는 설정된 글자 인식 엔진을 생성합니다.
+// 엔진을 사용할 수 없으면 서버는 그대로 시작하고, 사진으로 티켓 초안을 만드는 기능만 꺼집니다.
+func newTextRecognizer(cfg config.OCRConfig) domain.TextRecognizer {
+	if cfg.Engine == config.OCRNone {
+		return nil
+	}
+	tesseract, err := ocr.NewTesseract(cfg.TesseractPath, cfg.Languages)
+	if err != nil {
+		slog.Warn("글자 인식 기능을 사용하지 않습니다", "error", err)
+		return nil
+	}
+	return tesseract
 }
@@ -0,0 +1,167 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// 레이블에는 경로 패턴, 저장소 메서드처럼 개수가 정해진 값만 사용합니다.
+// 사용자 ID나 문서 ID를 레이블로 쓰면 시계열이 끝없이 늘어나므로 넣지 않습니다.
+
+const namespace = "tickit"
+
+const (
+	ProviderKakao = "kakao"
+
+	PresignUpload   = "upload"
+	PresignDownload = "download"
+)
+
+var registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "처리한 HTTP 요청 수",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP 요청 처리 시간",
+		Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"method", "route", "status"})
+
+	mongoDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "mongo_command_duration_seconds",
+		Help:      "MongoDB 명령 실행 시간",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"operation", "command", "result"})
+
+	presignedURLs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "presigned_urls_issued_total",
+		Help:      "발급한 서명된 URL 수 (upload: 업로드용, download: 이미지 조회용)",
+	}, []string{"kind"})
+
+	logins = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "logins_total",
+		Help:      "로그인 시도 수",
+	}, []string{"provider", "result"})
+
+	rateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limited_requests_total",
+		Help:      "요청 제한으로 거부한 요청 수",
+	}, []string{"policy"})
+
+	ticketsCreated = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tickets_created_total",
+		Help:      "서버가 시작된 뒤 만든 티켓 수",
+	})
+
+	imageGCRuns = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "image_gc_runs_total",
+		Help:      "미사용 이미지 정리 작업 수행 횟수",
+	}, []string{"result"})
+
+	imageGCDeleted = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "image_gc_deleted_total",
+		Help:      "미사용 이미지 정리로 지운 객체 수",
+	})
+
+	imageGCFreedBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "image_gc_freed_bytes_total",
+		Help:      "미사용 이미지 정리로 확보한 저장 공간(바이트)",
+	})
+
+	imageGCDeleteFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "image_gc_delete_failures_total",
+		Help:      "미사용 이미지 정리 중 지우지 못한 객체 수",
+	})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		mongoDuration,
+		presignedURLs,
+		logins,
+		rateLimited,
+		ticketsCreated,
+		imageGCRuns,
+		imageGCDeleted,
+		imageGCFreedBytes,
+		imageGCDeleteFailures,
+	)
+}
+
+// Handler는 수집한 지표를 Prometheus 텍스트 형식으로 보여줍니다
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// RegisterGauge는 수집할 때마다 value를 호출해 값을 읽는 게이지를 추가합니다.
+// value는 수집 요청마다 실행되므로 가벼워야 합니다.
+func RegisterGauge(name, help string, value func() float64) {
+	registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      name,
+		Help:      help,
+	}, value))
+}
+
+// ObserveRequest는 HTTP 요청 하나를 기록합니다. route는 등록된 경로 패턴이어야 합니다.
+func ObserveRequest(method, route string, status int, duration time.Duration) {
+	code := strconv.Itoa(status)
+	httpRequests.WithLabelValues(method, route, code).Inc()
+	httpDuration.WithLabelValues(method, route, code).Observe(duration.Seconds())
+}
+
+func PresignedURLIssued(kind string) {
+	presignedURLs.WithLabelValues(kind).Inc()
+}
+
+func LoginAttempted(provider string, success bool) {
+	result := "success"
+	if !success {
+		result = "failure"
+	}
+	logins.WithLabelValues(provider, result).Inc()
+}
+
+func RateLimited(policy string) {
+	rateLimited.WithLabelValues(policy).Inc()
+}
+
+func TicketCreated() {
+	ticketsCreated.Inc()
+}
+
+// ImageGCRun은 미사용 이미지 정리 작업 한 번의 결과를 기록합니다
+func ImageGCRun(success bool, deleted, failed int, freedBytes int64) {
+	result := "success"
+	if !success {
+		result = "failure"
+	}
+	imageGCRuns.WithLabelValues(result).Inc()
+	imageGCDeleted.Add(float64(deleted))
+	imageGCDeleteFailures.Add(float64(failed))
+	imageGCFreedBytes.Add(float64(freedBytes))
+}
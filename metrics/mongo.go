@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/event"
+)
+
+type operationKey struct{}
+
+// WithOperation은 이후 실행하는 MongoDB 명령을 저장소 메서드 이름(ticket.GetById 등)으로 집계하도록 표시합니다
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+func operation(ctx context.Context) string {
+	if name, ok := ctx.Value(operationKey{}).(string); ok {
+		return name
+	}
+	return "unknown"
+}
+
+// CommandMonitor는 MongoDB 명령의 실행 시간을 기록합니다. 클라이언트 옵션의 SetMonitor에 넘깁니다.
+func CommandMonitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			mongoDuration.WithLabelValues(operation(ctx), e.CommandName, "ok").Observe(e.Duration.Seconds())
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			mongoDuration.WithLabelValues(operation(ctx), e.CommandName, "error").Observe(e.Duration.Seconds())
+		},
+	}
+}
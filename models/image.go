@@ -0,0 +1,47 @@
+package models
+
+import "github.com/google/uuid"
+
+// MaxGalleryImages는 티켓·일정 하나에 등록할 수 있는 사진 수입니다
+const MaxGalleryImages = 10
+
+// Image는 티켓·일정 갤러리의 사진입니다
+type Image struct {
+	Id      string `json:"id" bson:"id"`
+	Key     string `json:"key" bson:"key"`
+	Caption string `json:"caption" bson:"caption"`
+}
+
+// Gallery는 저장된 사진 목록과 대표 사진 아이디를 반환합니다.
+// 사진 목록이 없던 시절의 문서는 image 하나를 대표 사진으로 하는 목록으로 바꿔서 반환하며,
+// 이 사진의 아이디는 키에서 만들어지므로 저장하기 전에도 항상 같습니다.
+func Gallery(images []Image, coverId, image string) ([]Image, string) {
+	if len(images) == 0 {
+		if image == "" {
+			return []Image{}, ""
+		}
+		legacy := Image{
+			Id:  uuid.NewSHA1(uuid.NameSpaceURL, []byte(image)).String(),
+			Key: image,
+		}
+		return []Image{legacy}, legacy.Id
+	}
+
+	for _, img := range images {
+		if img.Id == coverId {
+			return images, coverId
+		}
+	}
+	// 대표 사진이 지정되지 않았으면 첫 번째 사진을 대표로 사용합니다
+	return images, images[0].Id
+}
+
+// CoverKey는 대표 사진의 키입니다. 사진이 없으면 빈 문자열을 반환합니다.
+func CoverKey(images []Image, coverId string) string {
+	for _, img := range images {
+		if img.Id == coverId {
+			return img.Key
+		}
+	}
+	return ""
+}
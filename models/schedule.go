@@ -1,18 +1,123 @@
 package models
 
+import "time"
+
+type ScheduleStatus string
+
+const (
+	StatusPlanned   ScheduleStatus = "PLANNED"
+	StatusBooked    ScheduleStatus = "BOOKED"
+	StatusAttended  ScheduleStatus = "ATTENDED"
+	StatusCancelled ScheduleStatus = "CANCELLED"
+	StatusRefunded  ScheduleStatus = "REFUNDED"
+)
+
+// scheduleTransitions는 각 상태에서 이동할 수 있는 다음 상태 목록입니다
+var scheduleTransitions = map[ScheduleStatus][]ScheduleStatus{
+	StatusPlanned:   {StatusBooked, StatusCancelled},
+	StatusBooked:    {StatusAttended, StatusCancelled, StatusRefunded},
+	StatusCancelled: {StatusRefunded},
+	StatusAttended:  {},
+	StatusRefunded:  {},
+}
+
+func (s ScheduleStatus) IsValid() bool {
+	_, ok := scheduleTransitions[s]
+	return ok
+}
+
+// IsActive는 일정 충돌 검사 대상인지 여부를 반환합니다
+func (s ScheduleStatus) IsActive() bool {
+	return s != StatusCancelled && s != StatusRefunded
+}
+
+func (s ScheduleStatus) CanTransitionTo(next ScheduleStatus) bool {
+	for _, candidate := range scheduleTransitions[s] {
+		if candidate == next {
+			return true
+		}
+	}
+	return false
+}
+
+type EntryType string
+
+const (
+	EntryLottery  EntryType = "LOTTERY"
+	EntryWaitlist EntryType = "WAITLIST"
+)
+
+type LotteryOutcome string
+
+const (
+	LotteryPending LotteryOutcome = "PENDING"
+	LotteryWon     LotteryOutcome = "WON"
+	LotteryLost    LotteryOutcome = "LOST"
+)
+
+// LotteryEntry는 아직 예매로 이어지지 않은 추첨·취소표 대기 응모 기록입니다
+type LotteryEntry struct {
+	Type       EntryType      `json:"type" bson:"type"`
+	ResultDate string         `json:"resultDate" bson:"resultDate"`
+	Outcome    LotteryOutcome `json:"outcome" bson:"outcome"`
+	EnteredAt  time.Time      `json:"enteredAt" bson:"enteredAt"`
+	DecidedAt  time.Time      `json:"decidedAt,omitempty" bson:"decidedAt,omitempty"`
+}
+
+type StatusChange struct {
+	Status    ScheduleStatus `json:"status" bson:"status"`
+	ChangedAt time.Time      `json:"changedAt" bson:"changedAt"`
+}
+
 type Schedule struct {
-	Id        string `json:"id" bson:"_id,omitempty"`
-	UserId    string `json:"userId" bson:"userId"`
-	Date      string `json:"date" bson:"date"`
-	Title     string `json:"title" bson:"title"`
-	Number    int    `json:"number" bson:"number"`
-	Image     string `json:"image" bson:"image"`
-	Thumbnail bool   `json:"thumbnail" bson:"thumbnail"`
-	Location  string `json:"location" bson:"location"`
-	Time      string `json:"time" bson:"time"`
-	Seat      string `json:"seat" bson:"seat"`
-	Casting   string `json:"casting" bson:"casting"`
-	Company   string `json:"company" bson:"company"`
-	Link      string `json:"link" bson:"link"`
-	Memo      string `json:"memo" bson:"memo"`
+	Id            string         `json:"id" bson:"_id,omitempty"`
+	UserId        string         `json:"userId" bson:"userId"`
+	Date          string         `json:"date" bson:"date"`
+	Title         string         `json:"title" bson:"title"`
+	Number        int            `json:"number" bson:"number"`
+	Image         string         `json:"image" bson:"image"`
+	Images        []Image        `json:"images" bson:"images,omitempty"`
+	CoverImageId  string         `json:"coverImageId" bson:"coverImageId,omitempty"`
+	Thumbnail     bool           `json:"thumbnail" bson:"thumbnail"`
+	Location      string         `json:"location" bson:"location"`
+	Time          string         `json:"time" bson:"time"`
+	StartAt       time.Time      `json:"startAt" bson:"startAt"`
+	EndAt         time.Time      `json:"endAt" bson:"endAt"`
+	Duration      int            `json:"duration" bson:"duration"`
+	TimeZone      string         `json:"timeZone" bson:"timeZone"`
+	Seat          string         `json:"seat" bson:"seat"`
+	Casting       string         `json:"casting" bson:"casting"`
+	Company       string         `json:"company" bson:"company"`
+	Link          string         `json:"link" bson:"link"`
+	Memo          string         `json:"memo" bson:"memo"`
+	Status        ScheduleStatus `json:"status" bson:"status"`
+	StatusHistory []StatusChange `json:"statusHistory" bson:"statusHistory"`
+	TicketId      string         `json:"ticketId" bson:"ticketId"`
+	Lottery       *LotteryEntry  `json:"lottery,omitempty" bson:"lottery,omitempty"`
+
+	// GalleryVersion은 사진 목록을 저장할 때마다 1씩 늘어나며, 동시에 들어온 수정이 서로 덮어쓰지 않도록 확인하는 데 사용합니다
+	GalleryVersion int `json:"-" bson:"galleryVersion,omitempty"`
+}
+
+// CurrentStatus는 상태 필드가 없는 기존 일정을 PLANNED로 취급합니다
+func (s *Schedule) CurrentStatus() ScheduleStatus {
+	if s.Status == "" {
+		return StatusPlanned
+	}
+	return s.Status
+}
+
+// CanTransitionTo는 현재 상태에서 next로 바꿀 수 있는지 반환합니다.
+// 예매 기록 없이 다녀온 공연(현장 구매, 초대권 등)을 위해 시작 시간이 지난 PLANNED 일정은 바로 ATTENDED로 바꿀 수 있습니다.
+func (s *Schedule) CanTransitionTo(next ScheduleStatus, now time.Time) bool {
+	current := s.CurrentStatus()
+	if current.CanTransitionTo(next) {
+		return true
+	}
+	return current == StatusPlanned && next == StatusAttended && !s.StartAt.IsZero() && s.StartAt.Before(now)
+}
+
+// Gallery는 일정의 사진 목록과 대표 사진 아이디입니다. Image에는 대표 사진의 키가 저장됩니다.
+func (s *Schedule) Gallery() ([]Image, string) {
+	return Gallery(s.Images, s.CoverImageId, s.Image)
 }
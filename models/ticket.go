@@ -6,13 +6,25 @@ type Ticket struct {
 	Id              string    `json:"id" bson:"_id,omitempty"`
 	UserId          string    `json:"userId" bson:"userId"`
 	Image           string    `json:"image" bson:"image"`
+	Images          []Image   `json:"images" bson:"images,omitempty"`
+	CoverImageId    string    `json:"coverImageId" bson:"coverImageId,omitempty"`
 	Title           string    `json:"title" bson:"title"`
 	Location        string    `json:"location" bson:"location"`
 	DateTime        time.Time `json:"dateTime" bson:"dateTime"`
+	TimeZone        string    `json:"timeZone" bson:"timeZone"`
 	BackgroundColor string    `json:"backgroundColor" bson:"backgroundColor"`
 	ForegroundColor string    `json:"foregroundColor" bson:"foregroundColor"`
 	Fields          []Field   `json:"fields" bson:"fields"`
+	ScheduleId      string    `json:"scheduleId" bson:"scheduleId"`
 	CreatedAt       time.Time `json:"createdAt" bson:"createdAt"`
+
+	// GalleryVersion은 사진 목록을 저장할 때마다 1씩 늘어나며, 동시에 들어온 수정이 서로 덮어쓰지 않도록 확인하는 데 사용합니다
+	GalleryVersion int `json:"-" bson:"galleryVersion,omitempty"`
+}
+
+// Gallery는 티켓의 사진 목록과 대표 사진 아이디입니다. Image에는 대표 사진의 키가 저장됩니다.
+func (t *Ticket) Gallery() ([]Image, string) {
+	return Gallery(t.Images, t.CoverImageId, t.Image)
 }
 
 type Field struct {
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// Upload는 업로드할 이미지의 기록입니다. URL을 발급할 때 저장 공간을 예약하며 기록되고,
+// 서버에서 확인을 마치면 ConfirmedAt이 채워집니다.
+type Upload struct {
+	Id          string    `json:"id" bson:"_id,omitempty"`
+	UserId      string    `json:"userId" bson:"userId"`
+	Key         string    `json:"key" bson:"key"`
+	ContentType string    `json:"contentType" bson:"contentType"`
+	Size        int64     `json:"size" bson:"size"`
+	ConfirmedAt time.Time `json:"confirmedAt" bson:"confirmedAt,omitempty"`
+	// Thumbnails는 생성이 끝난 썸네일의 가로 크기 목록입니다
+	Thumbnails []int `json:"thumbnails" bson:"thumbnails,omitempty"`
+
+	// ReservedAt은 저장 공간을 예약한 시각입니다. 예약 없이 기록된 이전 업로드는 비어 있습니다.
+	ReservedAt time.Time `json:"-" bson:"reservedAt,omitempty"`
+}
+
+// Confirmed는 서버에서 확인을 마친 업로드인지 여부입니다
+func (u *Upload) Confirmed() bool {
+	return !u.ConfirmedAt.IsZero()
+}
@@ -9,6 +9,13 @@ const (
 	OAuthGoogle OAuthType = "GOOGLE"
 )
 
+type Plan string
+
+const (
+	PlanFree    Plan = "FREE"
+	PlanPremium Plan = "PREMIUM"
+)
+
 type User struct {
 	Id      string `json:"id" bson:"_id,omitempty"`
 	OAuthId string `json:"oauthId" bson:"oauthId"`
@@ -18,4 +25,19 @@ type User struct {
 	// CreatedAt time.Time `json:"createdAt" bson:"createdAt,omitempty"`
 	RefreshToken string    `json:"refreshToken" bson:"refreshToken"`
 	TokenExpiry  time.Time `json:"tokenExpiry" bson:"tokenExpiry"`
+	TimeZone     string    `json:"timeZone" bson:"timeZone"`
+	// Locale은 사용자가 고른 응답 언어입니다. 비어 있으면 요청의 Accept-Language를 따릅니다.
+	Locale string `json:"locale" bson:"locale,omitempty"`
+	Plan   Plan   `json:"plan" bson:"plan,omitempty"`
+
+	// StorageUsedBytes는 업로드에 예약한 저장 공간의 합계입니다. 처음 예약할 때 업로드 기록으로부터 채워지므로 nil일 수 있습니다.
+	StorageUsedBytes *int64 `json:"-" bson:"storageUsedBytes,omitempty"`
+}
+
+// CurrentPlan은 사용자의 요금제입니다. 요금제가 없던 시절에 가입한 사용자는 FREE입니다.
+func (u *User) CurrentPlan() Plan {
+	if u.Plan == "" {
+		return PlanFree
+	}
+	return u.Plan
 }
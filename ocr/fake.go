@@ -0,0 +1,32 @@
+package ocr
+
+import (
+	"context"
+
+	"github.com/doyeon0307/tickit-backend/domain"
+)
+
+// Fake는 정해진 결과를 돌려주는 TextRecognizer입니다. 테스트에서 OCR 엔진 대신 사용합니다.
+type Fake struct {
+	Lines []domain.TextLine
+	Err   error
+	// Images는 Recognize로 받은 이미지입니다
+	Images [][]byte
+}
+
+// NewFake는 lines를 신뢰도 1로 돌려주는 Fake를 만듭니다
+func NewFake(lines ...string) *Fake {
+	fake := &Fake{}
+	for _, line := range lines {
+		fake.Lines = append(fake.Lines, domain.TextLine{Text: line, Confidence: 1})
+	}
+	return fake
+}
+
+func (f *Fake) Recognize(ctx context.Context, image []byte) ([]domain.TextLine, error) {
+	f.Images = append(f.Images, image)
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	return f.Lines, nil
+}
@@ -0,0 +1,110 @@
+package ocr
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/doyeon0307/tickit-backend/domain"
+)
+
+// Tesseract는 서버에 설치된 tesseract로 글자를 인식합니다. 네트워크 없이 동작하며,
+// 한국어 인식에는 kor 언어 데이터(tesseract-ocr-kor)가 필요합니다.
+// 인식은 CPU를 많이 쓰므로 동시에 CPU 개수만큼만 실행합니다.
+type Tesseract struct {
+	path      string
+	languages string
+	slots     chan struct{}
+}
+
+func NewTesseract(path, languages string) (*Tesseract, error) {
+	resolved, err := exec.LookPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("tesseract를 찾을 수 없습니다: %v", err)
+	}
+	return &Tesseract{
+		path:      resolved,
+		languages: languages,
+		slots:     make(chan struct{}, runtime.NumCPU()),
+	}, nil
+}
+
+func (t *Tesseract) Recognize(ctx context.Context, image []byte) ([]domain.TextLine, error) {
+	select {
+	case t.slots <- struct{}{}:
+		defer func() { <-t.slots }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, t.path, "stdin", "stdout", "-l", t.languages, "tsv")
+	cmd.Stdin = bytes.NewReader(image)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("tesseract 실행 실패: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return parseTSV(&stdout)
+}
+
+// parseTSV는 tesseract의 단어 단위 TSV 출력을 줄 단위로 묶습니다.
+// 줄의 신뢰도는 단어 신뢰도(0~100)의 평균을 0~1로 바꾼 값입니다.
+func parseTSV(output *bytes.Buffer) ([]domain.TextLine, error) {
+	type line struct {
+		words      []string
+		confidence float64
+	}
+	var order []string
+	lines := make(map[string]*line)
+
+	scanner := bufio.NewScanner(output)
+	header := true
+	for scanner.Scan() {
+		if header {
+			header = false
+			continue
+		}
+		// level page block par line word left top width height conf text
+		columns := strings.Split(scanner.Text(), "\t")
+		if len(columns) < 12 || columns[0] != "5" {
+			continue
+		}
+		text := strings.TrimSpace(columns[11])
+		if text == "" {
+			continue
+		}
+		confidence, err := strconv.ParseFloat(columns[10], 64)
+		if err != nil || confidence < 0 {
+			continue
+		}
+
+		id := strings.Join(columns[1:5], "-")
+		l, ok := lines[id]
+		if !ok {
+			l = &line{}
+			lines[id] = l
+			order = append(order, id)
+		}
+		l.words = append(l.words, text)
+		l.confidence += confidence
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.TextLine, len(order))
+	for i, id := range order {
+		l := lines[id]
+		result[i] = domain.TextLine{
+			Text:       strings.Join(l.words, " "),
+			Confidence: l.confidence / float64(len(l.words)) / 100,
+		}
+	}
+	return result, nil
+}
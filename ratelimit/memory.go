@@ -0,0 +1,71 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore는 버킷을 서버 메모리에 보관합니다. 서버가 한 대일 때 사용합니다.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	policy  Policy
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *MemoryStore) Take(ctx context.Context, key string, policy Policy) (Result, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(policy.Burst), updated: now, policy: policy}
+		s.buckets[key] = b
+	}
+	b.refill(now)
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+	return newResult(policy, b.tokens, allowed), nil
+}
+
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updated)
+	b.tokens = min(float64(b.policy.Burst), b.tokens+float64(elapsed)/float64(b.policy.refillInterval()))
+	b.updated = now
+}
+
+// sweep은 가득 찬 버킷을 지웁니다. 지워도 다음 요청에서 가득 찬 버킷으로 다시 만들어지므로 결과는 같습니다.
+// 버킷 전체를 훑으므로 1분에 한 번만 수행합니다.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	s.lastSweep = now
+
+	for key, b := range s.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.policy.Burst) {
+			delete(s.buckets, key)
+		}
+	}
+}
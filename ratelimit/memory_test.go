@@ -0,0 +1,167 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// slowPolicy는 테스트 도중 토큰이 다시 채워지지 않을 만큼 느린 정책입니다
+var slowPolicy = Policy{Name: "test", Limit: 1, Period: time.Hour, Burst: 3}
+
+// rewind는 key의 버킷이 d만큼 전에 갱신된 것으로 만들어 시간이 흐른 것처럼 보이게 합니다
+func rewind(s *MemoryStore, key string, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.buckets[key].updated = s.buckets[key].updated.Add(-d)
+}
+
+func TestMemoryStoreTake(t *testing.T) {
+	tests := []struct {
+		name string
+		// wait는 마지막 요청 전에 흐른 것으로 볼 시간입니다
+		wait          time.Duration
+		takes         int
+		wantAllowed   bool
+		wantRemaining int
+	}{
+		{name: "Burst만큼은 바로 허용합니다", takes: 3, wantAllowed: true, wantRemaining: 0},
+		{name: "Burst를 넘으면 거부합니다", takes: 4, wantAllowed: false, wantRemaining: 0},
+		{name: "토큰 하나가 채워지면 다시 허용합니다", takes: 4, wait: time.Hour, wantAllowed: true, wantRemaining: 0},
+		{name: "채워지는 중인 토큰은 쓸 수 없습니다", takes: 4, wait: 59 * time.Minute, wantAllowed: false, wantRemaining: 0},
+		{name: "Burst보다 많이 채워지지 않습니다", takes: 4, wait: 24 * time.Hour, wantAllowed: true, wantRemaining: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			ctx := context.Background()
+
+			var result Result
+			for i := 0; i < tt.takes; i++ {
+				if i == tt.takes-1 && tt.wait > 0 {
+					rewind(store, "user", tt.wait)
+				}
+				var err error
+				if result, err = store.Take(ctx, "user", slowPolicy); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Remaining != tt.wantRemaining {
+				t.Errorf("Remaining = %d, want %d", result.Remaining, tt.wantRemaining)
+			}
+			if result.Limit != slowPolicy.Burst {
+				t.Errorf("Limit = %d, want %d", result.Limit, slowPolicy.Burst)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreRetryAfter(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	for i := 0; i < slowPolicy.Burst; i++ {
+		if _, err := store.Take(ctx, "user", slowPolicy); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rewind(store, "user", 20*time.Minute)
+
+	result, err := store.Take(ctx, "user", slowPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Allowed {
+		t.Fatal("Allowed = true, want false")
+	}
+	// 토큰 하나가 채워지기까지 40분, 가득 차기까지 2시간 40분이 남았습니다
+	if diff := result.RetryAfter - 40*time.Minute; diff < -time.Second || diff > time.Second {
+		t.Errorf("RetryAfter = %v, want 40m", result.RetryAfter)
+	}
+	if diff := result.ResetAfter - 160*time.Minute; diff < -time.Second || diff > time.Second {
+		t.Errorf("ResetAfter = %v, want 2h40m", result.ResetAfter)
+	}
+}
+
+func TestMemoryStoreKeysAreIndependent(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	for i := 0; i <= slowPolicy.Burst; i++ {
+		if _, err := store.Take(ctx, "a", slowPolicy); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := store.Take(ctx, "b", slowPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Allowed || result.Remaining != slowPolicy.Burst-1 {
+		t.Errorf("other key = %+v, want a fresh bucket", result)
+	}
+}
+
+func TestMemoryStoreSweep(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	if _, err := store.Take(ctx, "full", slowPolicy); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i <= slowPolicy.Burst; i++ {
+		if _, err := store.Take(ctx, "empty", slowPolicy); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// "full"은 다시 가득 찼고 "empty"는 아직 비어 있습니다
+	rewind(store, "full", time.Hour)
+	store.mu.Lock()
+	store.lastSweep = store.lastSweep.Add(-2 * time.Minute)
+	store.mu.Unlock()
+
+	if _, err := store.Take(ctx, "other", slowPolicy); err != nil {
+		t.Fatal(err)
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if _, ok := store.buckets["full"]; ok {
+		t.Error("full bucket was not swept")
+	}
+	if _, ok := store.buckets["empty"]; !ok {
+		t.Error("bucket with used tokens was swept")
+	}
+}
+
+func TestRulesFor(t *testing.T) {
+	login := Policy{Name: "login", Limit: 5, Period: time.Minute, Burst: 5}
+	rules := Rules{
+		Default: slowPolicy,
+		Routes:  map[string]Policy{"POST /api/auth/kakao/login": login},
+	}
+
+	tests := []struct {
+		method string
+		route  string
+		want   string
+	}{
+		{method: "POST", route: "/api/auth/kakao/login", want: "login"},
+		{method: "GET", route: "/api/auth/kakao/login", want: "test"},
+		{method: "POST", route: "/api/tickets", want: "test"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.route, func(t *testing.T) {
+			if got := rules.For(tt.method, tt.route); got.Name != tt.want {
+				t.Errorf("For(%s, %s) = %s, want %s", tt.method, tt.route, got.Name, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Policy는 토큰 버킷 하나의 규칙입니다. 버킷은 Burst개의 토큰으로 시작하고 Period마다 Limit개씩 다시 채워지며,
+// 요청 하나가 토큰 하나를 씁니다.
+type Policy struct {
+	// Name은 저장소 키에 들어가므로 정책마다 따로 집계됩니다
+	Name   string
+	Limit  int
+	Period time.Duration
+	Burst  int
+}
+
+// refillInterval은 토큰 하나가 다시 채워지는 데 걸리는 시간입니다
+func (p Policy) refillInterval() time.Duration {
+	return p.Period / time.Duration(p.Limit)
+}
+
+// Result는 요청 하나를 확인한 결과입니다
+type Result struct {
+	Allowed bool
+	// Limit은 버킷의 크기입니다
+	Limit     int
+	Remaining int
+	// RetryAfter는 거부된 요청을 다시 보낼 수 있을 때까지 남은 시간입니다
+	RetryAfter time.Duration
+	// ResetAfter는 버킷이 가득 찰 때까지 남은 시간입니다
+	ResetAfter time.Duration
+}
+
+// Store는 버킷을 보관합니다. 서버가 여러 대면 RedisStore로 버킷을 공유합니다.
+type Store interface {
+	// Take는 key의 버킷에서 토큰 하나를 꺼냅니다
+	Take(ctx context.Context, key string, policy Policy) (Result, error)
+}
+
+// Rules는 경로별 정책입니다. Routes의 키는 "메서드 경로 패턴" 형식입니다 (POST /api/auth/kakao/login).
+type Rules struct {
+	Default Policy
+	Routes  map[string]Policy
+}
+
+func (r Rules) For(method, route string) Policy {
+	if policy, ok := r.Routes[method+" "+route]; ok {
+		return policy
+	}
+	return r.Default
+}
+
+// newResult는 토큰을 꺼낸 뒤 남은 토큰 수로 결과를 만듭니다
+func newResult(policy Policy, tokens float64, allowed bool) Result {
+	interval := policy.refillInterval()
+	result := Result{
+		Allowed:    allowed,
+		Limit:      policy.Burst,
+		Remaining:  int(math.Floor(tokens)),
+		ResetAfter: time.Duration((float64(policy.Burst) - tokens) * float64(interval)),
+	}
+	if !allowed {
+		result.RetryAfter = time.Duration((1 - tokens) * float64(interval))
+	}
+	return result
+}
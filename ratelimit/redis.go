@@ -0,0 +1,72 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const redisKeyPrefix = "tickit:ratelimit:"
+
+// takeScript는 버킷을 채우고 토큰을 꺼내는 과정을 Redis 안에서 한 번에 수행합니다.
+// 서버마다 시계가 다를 수 있으므로 현재 시각은 Redis의 TIME을 사용합니다.
+var takeScript = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'updated')
+local tokens = tonumber(state[1]) or burst
+local updated = tonumber(state[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - updated) / interval)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil((burst - tokens) * interval / 1000) + 1000)
+return {allowed, tostring(tokens)}
+`)
+
+// RedisStore는 버킷을 Redis에 보관해 여러 서버가 같은 한도를 나눠 씁니다
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore는 redis://[:password@]host:port/db 형식의 주소로 연결합니다
+func NewRedisStore(url string) (*RedisStore, error) {
+	options, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	return &RedisStore{client: redis.NewClient(options)}, nil
+}
+
+func (s *RedisStore) Take(ctx context.Context, key string, policy Policy) (Result, error) {
+	interval := policy.refillInterval().Microseconds()
+	values, err := takeScript.Run(ctx, s.client, []string{redisKeyPrefix + key}, policy.Burst, interval).Slice()
+	if err != nil {
+		return Result{}, err
+	}
+
+	allowed, _ := values[0].(int64)
+	raw, _ := values[1].(string)
+	tokens, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return Result{}, err
+	}
+	return newResult(policy, tokens, allowed == 1), nil
+}
+
+func (s *RedisStore) Ping(ctx context.Context) error {
+	return s.client.Ping(ctx).Err()
+}
+
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
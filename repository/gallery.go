@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// galleryVersionFilter는 사진 목록을 읽은 뒤 다른 요청이 목록을 바꾸지 않았는지 확인하는 조건입니다.
+// 사진 목록을 저장할 때마다 galleryVersion을 1씩 올리며, 버전이 없던 문서는 0으로 취급합니다.
+func galleryVersionFilter(version int) any {
+	if version == 0 {
+		return bson.M{"$in": bson.A{nil, 0}}
+	}
+	return version
+}
+
+// galleryWriteError는 사진 목록 저장 조건에 맞는 문서가 없을 때
+// 문서가 없는 것인지, 읽은 뒤 다른 요청이 사진 목록을 바꾼 것인지 구분합니다
+func galleryWriteError(ctx context.Context, collection *mongo.Collection, objID primitive.ObjectID, userId, notFoundKey string) error {
+	count, err := collection.CountDocuments(ctx, bson.M{"_id": objID, "userId": userId}, options.Count().SetLimit(1))
+	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+	if count == 0 {
+		return &common.AppError{
+			Code: common.ErrNotFound,
+			Key:  notFoundKey,
+		}
+	}
+	return &common.AppError{
+		Code: common.ErrConflict,
+		Key:  "gallery.conflict",
+	}
+}
@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/doyeon0307/tickit-backend/common"
 	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/metrics"
 	"github.com/doyeon0307/tickit-backend/models"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -22,7 +24,21 @@ func NewScheduleRepository(db *mongo.Database) domain.ScheduleRepository {
 	}
 }
 
-func (m *scheduleRepository) GetPreviewsForTicket(ctx context.Context, userId, date string) ([]*models.Schedule, error) {
+// statusFilter는 상태 필드가 없는 기존 일정도 PLANNED로 조회되도록 조건을 만듭니다
+func statusFilter(statuses []models.ScheduleStatus) bson.M {
+	values := bson.A{}
+	for _, status := range statuses {
+		values = append(values, status)
+		if status == models.StatusPlanned {
+			values = append(values, "", nil)
+		}
+	}
+	return bson.M{"$in": values}
+}
+
+func (m *scheduleRepository) GetPreviewsForTicket(ctx context.Context, userId, date string, statuses []models.ScheduleStatus) ([]*models.Schedule, error) {
+	ctx = metrics.WithOperation(ctx, "schedule.GetPreviewsForTicket")
+
 	previews := make([]*models.Schedule, 0)
 
 	filter := bson.M{
@@ -30,26 +46,25 @@ func (m *scheduleRepository) GetPreviewsForTicket(ctx context.Context, userId, d
 		"date": bson.M{
 			"$lte": date,
 		},
+		// 이미 티켓으로 만들어진 일정은 제외
+		"ticketId": bson.M{
+			"$in": bson.A{"", nil},
+		},
+	}
+	if len(statuses) > 0 {
+		filter["status"] = statusFilter(statuses)
 	}
 
 	opts := options.Find().SetSort(bson.M{"date": -1})
 
 	cursor, err := m.collection.Find(ctx, filter, opts)
 	if err != nil {
-		return nil, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return nil, common.ServerError(ctx, "common.database_error", err)
 	}
 	defer cursor.Close(ctx)
 
 	if err = cursor.All(ctx, &previews); err != nil {
-		return nil, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return nil, common.ServerError(ctx, "common.database_error", err)
 	}
 
 	if previews == nil {
@@ -59,7 +74,9 @@ func (m *scheduleRepository) GetPreviewsForTicket(ctx context.Context, userId, d
 	return previews, nil
 }
 
-func (m *scheduleRepository) GetPreviewsForCalendar(ctx context.Context, userId, startDate, endDate string) ([]*models.Schedule, error) {
+func (m *scheduleRepository) GetPreviewsForCalendar(ctx context.Context, userId, startDate, endDate string, statuses []models.ScheduleStatus) ([]*models.Schedule, error) {
+	ctx = metrics.WithOperation(ctx, "schedule.GetPreviewsForCalendar")
+
 	previews := make([]*models.Schedule, 0)
 
 	filter := bson.M{
@@ -69,25 +86,20 @@ func (m *scheduleRepository) GetPreviewsForCalendar(ctx context.Context, userId,
 			"$lte": endDate,
 		},
 	}
+	if len(statuses) > 0 {
+		filter["status"] = statusFilter(statuses)
+	}
 
 	opts := options.Find().SetSort(bson.M{"date": 1})
 
 	cursor, err := m.collection.Find(ctx, filter, opts)
 	if err != nil {
-		return nil, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return nil, common.ServerError(ctx, "common.database_error", err)
 	}
 	defer cursor.Close(ctx)
 
 	if err = cursor.All(ctx, &previews); err != nil {
-		return nil, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return nil, common.ServerError(ctx, "common.database_error", err)
 	}
 
 	if previews == nil {
@@ -98,12 +110,14 @@ func (m *scheduleRepository) GetPreviewsForCalendar(ctx context.Context, userId,
 }
 
 func (m *scheduleRepository) GetById(ctx context.Context, userId, id string) (*models.Schedule, error) {
+	ctx = metrics.WithOperation(ctx, "schedule.GetById")
+
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return nil, &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "아이디 형식이 잘못되었습니다",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "request.id_invalid",
+			Err:  err,
 		}
 	}
 
@@ -112,30 +126,24 @@ func (m *scheduleRepository) GetById(ctx context.Context, userId, id string) (*m
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, &common.AppError{
-				Code:    common.ErrNotFound,
-				Message: "일정이 존재하지 않습니다. 아이디를 확인해주세요.",
-				Err:     err,
+				Code: common.ErrNotFound,
+				Key:  "schedule.not_found",
+				Err:  err,
 			}
 		}
-		return nil, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return nil, common.ServerError(ctx, "common.database_error", err)
 	}
 
 	return &schedule, nil
 }
 
 func (m *scheduleRepository) Create(ctx context.Context, schedule *models.Schedule) (string, error) {
+	ctx = metrics.WithOperation(ctx, "schedule.Create")
+
 	// Schedule에 이미 UserId가 설정되어 있다고 가정
 	result, err := m.collection.InsertOne(ctx, schedule)
 	if err != nil {
-		return "", &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return "", common.ServerError(ctx, "common.database_error", err)
 	}
 
 	schedule.Id = result.InsertedID.(primitive.ObjectID).Hex()
@@ -143,91 +151,507 @@ func (m *scheduleRepository) Create(ctx context.Context, schedule *models.Schedu
 }
 
 func (m *scheduleRepository) Update(ctx context.Context, userId, id string, schedule *models.Schedule) error {
+	ctx = metrics.WithOperation(ctx, "schedule.Update")
+
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "아이디 형식이 잘못되었습니다",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "request.id_invalid",
+			Err:  err,
 		}
 	}
 
 	// userId 검증을 위한 필터 추가
+	// 대표 사진 교체로 사진 목록도 함께 저장하므로 읽은 뒤 목록이 바뀌지 않았는지 확인합니다
 	filter := bson.M{
-		"_id":    objID,
-		"userId": userId,
+		"_id":            objID,
+		"userId":         userId,
+		"galleryVersion": galleryVersionFilter(schedule.GalleryVersion),
 	}
 
 	update := bson.M{
 		"$set": bson.M{
-			"date":      schedule.Date,
-			"title":     schedule.Title,
-			"number":    schedule.Number,
-			"image":     schedule.Image,
-			"thumbnail": schedule.Thumbnail,
-			"location":  schedule.Location,
-			"time":      schedule.Time,
-			"seat":      schedule.Seat,
-			"casting":   schedule.Casting,
-			"company":   schedule.Company,
-			"link":      schedule.Link,
-			"memo":      schedule.Memo,
-			"userId":    userId, // userId도 함께 업데이트
+			"date":         schedule.Date,
+			"title":        schedule.Title,
+			"number":       schedule.Number,
+			"image":        schedule.Image,
+			"images":       schedule.Images,
+			"coverImageId": schedule.CoverImageId,
+			"thumbnail":    schedule.Thumbnail,
+			"location":     schedule.Location,
+			"time":         schedule.Time,
+			"startAt":      schedule.StartAt,
+			"endAt":        schedule.EndAt,
+			"duration":     schedule.Duration,
+			"timeZone":     schedule.TimeZone,
+			"seat":         schedule.Seat,
+			"casting":      schedule.Casting,
+			"company":      schedule.Company,
+			"link":         schedule.Link,
+			"memo":         schedule.Memo,
+			"userId":       userId, // userId도 함께 업데이트
 		},
+		"$inc": bson.M{"galleryVersion": 1},
 	}
 
 	result, err := m.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return galleryWriteError(ctx, m.collection, objID, userId, "schedule.update_not_found")
+	}
+
+	return nil
+}
+
+func (m *scheduleRepository) Delete(ctx context.Context, userId, id string) error {
+	ctx = metrics.WithOperation(ctx, "schedule.Delete")
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "request.id_invalid",
+			Err:  err,
+		}
+	}
+
+	// userId 검증을 위한 필터 추가
+	filter := bson.M{
+		"_id":    objID,
+		"userId": userId,
+	}
+
+	result, err := m.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+
+	if result.DeletedCount == 0 {
 		return &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
+			Code: common.ErrNotFound,
+			Key:  "schedule.delete_not_found",
+			Err:  err,
 		}
 	}
 
+	return nil
+}
+
+func (m *scheduleRepository) UpdateStatus(ctx context.Context, userId, id string, from, to models.ScheduleStatus, changedAt time.Time) error {
+	ctx = metrics.WithOperation(ctx, "schedule.UpdateStatus")
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "request.id_invalid",
+			Err:  err,
+		}
+	}
+
+	// 현재 상태까지 조건에 포함해 동시에 들어온 상태 변경을 막습니다
+	filter := bson.M{
+		"_id":    objID,
+		"userId": userId,
+		"status": statusFilter([]models.ScheduleStatus{from}),
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"status": to,
+		},
+		"$push": bson.M{
+			"statusHistory": models.StatusChange{
+				Status:    to,
+				ChangedAt: changedAt,
+			},
+		},
+	}
+
+	result, err := m.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+
 	if result.MatchedCount == 0 {
 		return &common.AppError{
-			Code:    common.ErrNotFound,
-			Message: "존재하지 않는 일정이거나 수정 권한이 없습니다",
-			Err:     err,
+			Code: common.ErrConflict,
+			Key:  "schedule.status_conflict",
+			Err:  err,
 		}
 	}
 
 	return nil
 }
 
-func (m *scheduleRepository) Delete(ctx context.Context, userId, id string) error {
+func (m *scheduleRepository) LinkTicket(ctx context.Context, userId, id, ticketId string) error {
+	ctx = metrics.WithOperation(ctx, "schedule.LinkTicket")
+
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "아이디 형식이 잘못되었습니다",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "request.id_invalid",
+			Err:  err,
 		}
 	}
 
-	// userId 검증을 위한 필터 추가
 	filter := bson.M{
 		"_id":    objID,
 		"userId": userId,
+		"ticketId": bson.M{
+			"$in": bson.A{"", nil},
+		},
 	}
 
-	result, err := m.collection.DeleteOne(ctx, filter)
+	update := bson.M{
+		"$set": bson.M{
+			"ticketId": ticketId,
+		},
+	}
+
+	result, err := m.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+
+	if result.MatchedCount == 0 {
 		return &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
+			Code: common.ErrConflict,
+			Key:  "schedule.already_ticketed",
+			Err:  err,
 		}
 	}
 
-	if result.DeletedCount == 0 {
+	return nil
+}
+
+func (m *scheduleRepository) UnlinkTicket(ctx context.Context, userId, ticketId string) error {
+	ctx = metrics.WithOperation(ctx, "schedule.UnlinkTicket")
+
+	update := bson.M{
+		"$set": bson.M{
+			"ticketId": "",
+		},
+	}
+
+	_, err := m.collection.UpdateMany(ctx, bson.M{"userId": userId, "ticketId": ticketId}, update)
+	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+
+	return nil
+}
+
+// activeFilter는 취소되거나 환불된 일정을 충돌 검사에서 제외합니다
+func activeFilter() bson.M {
+	return bson.M{
+		"$nin": bson.A{models.StatusCancelled, models.StatusRefunded},
+	}
+}
+
+func (m *scheduleRepository) FindOverlapping(ctx context.Context, userId string, start, end time.Time, excludeId string) ([]*models.Schedule, error) {
+	ctx = metrics.WithOperation(ctx, "schedule.FindOverlapping")
+
+	schedules := make([]*models.Schedule, 0)
+
+	filter := bson.M{
+		"userId":  userId,
+		"status":  activeFilter(),
+		"startAt": bson.M{"$lt": end},
+		"endAt":   bson.M{"$gt": start},
+	}
+	if excludeId != "" {
+		objID, err := primitive.ObjectIDFromHex(excludeId)
+		if err != nil {
+			return nil, &common.AppError{
+				Code: common.ErrBadRequest,
+				Key:  "request.id_invalid",
+				Err:  err,
+			}
+		}
+		filter["_id"] = bson.M{"$ne": objID}
+	}
+
+	opts := options.Find().SetSort(bson.M{"startAt": 1})
+
+	cursor, err := m.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+	defer cursor.Close(ctx)
+
+	if err = cursor.All(ctx, &schedules); err != nil {
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+
+	return schedules, nil
+}
+
+func (m *scheduleRepository) GetActiveEndingAfter(ctx context.Context, userId string, after time.Time) ([]*models.Schedule, error) {
+	ctx = metrics.WithOperation(ctx, "schedule.GetActiveEndingAfter")
+
+	schedules := make([]*models.Schedule, 0)
+
+	filter := bson.M{
+		"userId": userId,
+		"status": activeFilter(),
+		"endAt":  bson.M{"$gt": after},
+	}
+
+	opts := options.Find().SetSort(bson.M{"startAt": 1})
+
+	cursor, err := m.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+	defer cursor.Close(ctx)
+
+	if err = cursor.All(ctx, &schedules); err != nil {
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+
+	return schedules, nil
+}
+
+func (m *scheduleRepository) GetWithoutInterval(ctx context.Context) ([]*models.Schedule, error) {
+	ctx = metrics.WithOperation(ctx, "schedule.GetWithoutInterval")
+
+	schedules := make([]*models.Schedule, 0)
+
+	// 필드가 없는 문서도 null 조건에 포함됩니다
+	cursor, err := m.collection.Find(ctx, bson.M{"startAt": nil})
+	if err != nil {
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+	defer cursor.Close(ctx)
+
+	if err = cursor.All(ctx, &schedules); err != nil {
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+
+	return schedules, nil
+}
+
+func (m *scheduleRepository) SetInterval(ctx context.Context, id string, start, end time.Time) error {
+	ctx = metrics.WithOperation(ctx, "schedule.SetInterval")
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
 		return &common.AppError{
-			Code:    common.ErrNotFound,
-			Message: "존재하지 않는 일정이거나 삭제 권한이 없습니다",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "request.id_invalid",
+			Err:  err,
 		}
 	}
 
+	// 그 사이 사용자가 일정을 수정해 값이 채워졌다면 덮어쓰지 않습니다
+	filter := bson.M{
+		"_id":     objID,
+		"startAt": nil,
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"startAt": start,
+			"endAt":   end,
+		},
+	}
+
+	if _, err := m.collection.UpdateOne(ctx, filter, update); err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+
+	return nil
+}
+
+func (m *scheduleRepository) SetLottery(ctx context.Context, userId, id string, entry *models.LotteryEntry) error {
+	ctx = metrics.WithOperation(ctx, "schedule.SetLottery")
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "request.id_invalid",
+			Err:  err,
+		}
+	}
+
+	// 조회 이후 예매 상태가 바뀌었거나 다른 요청이 먼저 응모를 등록한 일정은 변경하지 않습니다
+	filter := bson.M{
+		"_id":             objID,
+		"userId":          userId,
+		"status":          statusFilter([]models.ScheduleStatus{models.StatusPlanned}),
+		"lottery.outcome": bson.M{"$ne": models.LotteryPending},
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"lottery": entry,
+		},
+	}
+
+	result, err := m.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return &common.AppError{
+			Code: common.ErrConflict,
+			Key:  "lottery.entry_conflict",
+			Err:  err,
+		}
+	}
+
+	return nil
+}
+
+func (m *scheduleRepository) DecideLottery(ctx context.Context, userId, id string, outcome models.LotteryOutcome, from, to models.ScheduleStatus, decidedAt time.Time) error {
+	ctx = metrics.WithOperation(ctx, "schedule.DecideLottery")
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "request.id_invalid",
+			Err:  err,
+		}
+	}
+
+	// 결과가 이미 나온 응모는 다시 변경하지 않고, 조회 이후 상태가 바뀐 일정도 변경하지 않습니다
+	filter := bson.M{
+		"_id":             objID,
+		"userId":          userId,
+		"lottery.outcome": models.LotteryPending,
+		"status":          statusFilter([]models.ScheduleStatus{from}),
+	}
+
+	set := bson.M{
+		"lottery.outcome":   outcome,
+		"lottery.decidedAt": decidedAt,
+	}
+	update := bson.M{"$set": set}
+	if to != from {
+		set["status"] = to
+		update["$push"] = bson.M{
+			"statusHistory": models.StatusChange{
+				Status:    to,
+				ChangedAt: decidedAt,
+			},
+		}
+	}
+
+	result, err := m.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return &common.AppError{
+			Code: common.ErrConflict,
+			Key:  "lottery.no_pending_entry",
+			Err:  err,
+		}
+	}
+
+	return nil
+}
+
+func (m *scheduleRepository) GetPendingLotteries(ctx context.Context, userId string) ([]*models.Schedule, error) {
+	ctx = metrics.WithOperation(ctx, "schedule.GetPendingLotteries")
+
+	schedules := make([]*models.Schedule, 0)
+
+	filter := bson.M{
+		"userId":          userId,
+		"lottery.outcome": models.LotteryPending,
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "lottery.resultDate", Value: 1}, {Key: "date", Value: 1}})
+
+	cursor, err := m.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+	defer cursor.Close(ctx)
+
+	if err = cursor.All(ctx, &schedules); err != nil {
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+
+	return schedules, nil
+}
+
+func (m *scheduleRepository) UpdateImages(ctx context.Context, userId, id string, images []models.Image, coverId string, version int) error {
+	ctx = metrics.WithOperation(ctx, "schedule.UpdateImages")
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "request.id_invalid",
+			Err:  err,
+		}
+	}
+
+	filter := bson.M{
+		"_id":            objID,
+		"userId":         userId,
+		"galleryVersion": galleryVersionFilter(version),
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"images":       images,
+			"coverImageId": coverId,
+			"image":        models.CoverKey(images, coverId),
+		},
+		"$inc": bson.M{"galleryVersion": 1},
+	}
+
+	result, err := m.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return galleryWriteError(ctx, m.collection, objID, userId, "schedule.update_not_found")
+	}
+
+	return nil
+}
+
+func (m *scheduleRepository) ForEachImage(ctx context.Context, visit func(image string)) error {
+	ctx = metrics.WithOperation(ctx, "schedule.ForEachImage")
+
+	// Distinct는 결과를 문서 하나(16MB)에 담으므로 문서가 많아지면 실패합니다. 커서로 나누어 읽습니다.
+	// 사진 목록이 없던 문서는 image에만 키가 있으므로 두 필드를 모두 읽습니다.
+	opts := options.Find().SetProjection(bson.M{"image": 1, "images.key": 1})
+	cursor, err := m.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var schedule models.Schedule
+		if err := cursor.Decode(&schedule); err != nil {
+			return common.ServerError(ctx, "common.database_error", err)
+		}
+		if schedule.Image != "" {
+			visit(schedule.Image)
+		}
+		for _, image := range schedule.Images {
+			visit(image.Key)
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/doyeon0307/tickit-backend/common"
 	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/metrics"
 	"github.com/doyeon0307/tickit-backend/models"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -24,6 +25,8 @@ func NewTicketRepository(db *mongo.Database) domain.TicketRepository {
 }
 
 func (m *ticketRepository) GetPreviews(ctx context.Context, userId string) ([]*models.Ticket, error) {
+	ctx = metrics.WithOperation(ctx, "ticket.GetPreviews")
+
 	previews := make([]*models.Ticket, 0)
 
 	filter := bson.M{
@@ -34,20 +37,12 @@ func (m *ticketRepository) GetPreviews(ctx context.Context, userId string) ([]*m
 
 	cursor, err := m.collection.Find(ctx, filter, opts)
 	if err != nil {
-		return nil, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return nil, common.ServerError(ctx, "common.database_error", err)
 	}
 	defer cursor.Close(ctx)
 
 	if err = cursor.All(ctx, &previews); err != nil {
-		return nil, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return nil, common.ServerError(ctx, "common.database_error", err)
 	}
 
 	if previews == nil {
@@ -58,12 +53,14 @@ func (m *ticketRepository) GetPreviews(ctx context.Context, userId string) ([]*m
 }
 
 func (m *ticketRepository) GetById(ctx context.Context, userId, id string) (*models.Ticket, error) {
+	ctx = metrics.WithOperation(ctx, "ticket.GetById")
+
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return nil, &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "아이디 형식이 잘못되었습니다",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "request.id_invalid",
+			Err:  err,
 		}
 	}
 
@@ -72,40 +69,38 @@ func (m *ticketRepository) GetById(ctx context.Context, userId, id string) (*mod
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, &common.AppError{
-				Code:    common.ErrNotFound,
-				Message: "티켓이 존재하지 않습니다. 아이디를 확인해주세요.",
-				Err:     err,
+				Code: common.ErrNotFound,
+				Key:  "ticket.not_found",
+				Err:  err,
 			}
 		}
-		return nil, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return nil, common.ServerError(ctx, "common.database_error", err)
 	}
 
 	return &ticket, nil
 }
 
 func (m *ticketRepository) Create(ctx context.Context, userId string, ticket *models.Ticket) (string, error) {
+	ctx = metrics.WithOperation(ctx, "ticket.Create")
+
 	model := *&models.Ticket{
 		Id:              ticket.Id,
 		UserId:          userId,
 		Image:           ticket.Image,
+		Images:          ticket.Images,
+		CoverImageId:    ticket.CoverImageId,
 		Title:           ticket.Title,
 		Location:        ticket.Location,
 		DateTime:        ticket.DateTime,
+		TimeZone:        ticket.TimeZone,
 		BackgroundColor: ticket.BackgroundColor,
 		ForegroundColor: ticket.ForegroundColor,
 		Fields:          ticket.Fields,
+		ScheduleId:      ticket.ScheduleId,
 	}
 	result, err := m.collection.InsertOne(ctx, model)
 	if err != nil {
-		return "", &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return "", common.ServerError(ctx, "common.database_error", err)
 	}
 
 	ticket.Id = result.InsertedID.(primitive.ObjectID).Hex()
@@ -113,12 +108,14 @@ func (m *ticketRepository) Create(ctx context.Context, userId string, ticket *mo
 }
 
 func (m *ticketRepository) Update(ctx context.Context, userId, id string, ticket *models.Ticket) error {
+	ctx = metrics.WithOperation(ctx, "ticket.Update")
+
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "아이디 형식이 잘못되었습니다",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "request.id_invalid",
+			Err:  err,
 		}
 	}
 
@@ -126,61 +123,141 @@ func (m *ticketRepository) Update(ctx context.Context, userId, id string, ticket
 		"$set": bson.M{
 			"userId":          userId,
 			"image":           ticket.Image,
+			"images":          ticket.Images,
+			"coverImageId":    ticket.CoverImageId,
 			"title":           ticket.Title,
 			"location":        ticket.Location,
-			"datetime":        ticket.DateTime,
+			"dateTime":        ticket.DateTime,
+			"timeZone":        ticket.TimeZone,
 			"backgroundColor": ticket.BackgroundColor,
 			"foregroundColor": ticket.ForegroundColor,
 			"fields":          ticket.Fields,
 		},
+		"$inc": bson.M{"galleryVersion": 1},
+	}
+
+	// 대표 사진 교체로 사진 목록도 함께 저장하므로 읽은 뒤 목록이 바뀌지 않았는지 확인합니다
+	filter := bson.M{
+		"_id":            objID,
+		"userId":         userId,
+		"galleryVersion": galleryVersionFilter(ticket.GalleryVersion),
 	}
 
-	result, err := m.collection.UpdateOne(ctx, bson.M{"_id": objID, "userId": userId}, update)
+	result, err := m.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
-		return &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return common.ServerError(ctx, "common.database_error", err)
 	}
 
 	if result.MatchedCount == 0 {
-		return &common.AppError{
-			Code:    common.ErrNotFound,
-			Message: "존재하지 않는 티켓은 수정할 수 없습니다",
-			Err:     err,
-		}
+		return galleryWriteError(ctx, m.collection, objID, userId, "ticket.update_not_found")
 	}
 
 	return nil
 }
 
-func (m *ticketRepository) Delete(ctx context.Context, id string) error {
+func (m *ticketRepository) Delete(ctx context.Context, userId, id string) error {
+	ctx = metrics.WithOperation(ctx, "ticket.Delete")
+
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "아이디 형식이 잘못되었습니다",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "request.id_invalid",
+			Err:  err,
 		}
 	}
 
-	result, err := m.collection.DeleteOne(ctx, bson.M{"_id": objID})
+	result, err := m.collection.DeleteOne(ctx, bson.M{"_id": objID, "userId": userId})
 	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+
+	if result.DeletedCount == 0 {
 		return &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
+			Code: common.ErrNotFound,
+			Key:  "ticket.delete_not_found",
+			Err:  err,
 		}
 	}
 
-	if result.DeletedCount == 0 {
+	return nil
+}
+
+func (m *ticketRepository) UpdateImages(ctx context.Context, userId, id string, images []models.Image, coverId string, version int) error {
+	ctx = metrics.WithOperation(ctx, "ticket.UpdateImages")
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
 		return &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "존재하지 않는 티켓은 삭제할 수 없습니다",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "request.id_invalid",
+			Err:  err,
 		}
 	}
 
+	filter := bson.M{
+		"_id":            objID,
+		"userId":         userId,
+		"galleryVersion": galleryVersionFilter(version),
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"images":       images,
+			"coverImageId": coverId,
+			"image":        models.CoverKey(images, coverId),
+		},
+		"$inc": bson.M{"galleryVersion": 1},
+	}
+
+	result, err := m.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return galleryWriteError(ctx, m.collection, objID, userId, "ticket.update_not_found")
+	}
+
+	return nil
+}
+
+func (m *ticketRepository) ForEachImage(ctx context.Context, visit func(image string)) error {
+	ctx = metrics.WithOperation(ctx, "ticket.ForEachImage")
+
+	// Distinct는 결과를 문서 하나(16MB)에 담으므로 문서가 많아지면 실패합니다. 커서로 나누어 읽습니다.
+	// 사진 목록이 없던 문서는 image에만 키가 있으므로 두 필드를 모두 읽습니다.
+	opts := options.Find().SetProjection(bson.M{"image": 1, "images.key": 1})
+	cursor, err := m.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var ticket models.Ticket
+		if err := cursor.Decode(&ticket); err != nil {
+			return common.ServerError(ctx, "common.database_error", err)
+		}
+		if ticket.Image != "" {
+			visit(ticket.Image)
+		}
+		for _, image := range ticket.Images {
+			visit(image.Key)
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
 	return nil
 }
+
+func (m *ticketRepository) Count(ctx context.Context) (int64, error) {
+	ctx = metrics.WithOperation(ctx, "ticket.Count")
+
+	count, err := m.collection.EstimatedDocumentCount(ctx)
+	if err != nil {
+		return 0, common.ServerError(ctx, "common.database_error", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/metrics"
+	"github.com/doyeon0307/tickit-backend/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type uploadRepository struct {
+	collection *mongo.Collection
+}
+
+func NewUploadRepository(db *mongo.Database) domain.UploadRepository {
+	return &uploadRepository{
+		collection: db.Collection("uploads"),
+	}
+}
+
+func (m *uploadRepository) Save(ctx context.Context, upload *models.Upload) error {
+	ctx = metrics.WithOperation(ctx, "upload.Save")
+
+	set := bson.M{
+		"userId":      upload.UserId,
+		"key":         upload.Key,
+		"contentType": upload.ContentType,
+		"size":        upload.Size,
+	}
+	if !upload.ReservedAt.IsZero() {
+		set["reservedAt"] = upload.ReservedAt
+	}
+	if upload.Confirmed() {
+		set["confirmedAt"] = upload.ConfirmedAt
+	}
+	update := bson.M{"$set": set}
+
+	// 예약해 둔 기록을 확인하거나 같은 키를 다시 확인하면 기존 기록을 갱신합니다
+	opts := options.Update().SetUpsert(true)
+	_, err := m.collection.UpdateOne(ctx, bson.M{"key": upload.Key}, update, opts)
+	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+
+	return nil
+}
+
+func (m *uploadRepository) GetByKey(ctx context.Context, key string) (*models.Upload, error) {
+	ctx = metrics.WithOperation(ctx, "upload.GetByKey")
+
+	var upload models.Upload
+	err := m.collection.FindOne(ctx, bson.M{"key": key}).Decode(&upload)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, &common.AppError{
+				Code: common.ErrNotFound,
+				Key:  "upload.not_confirmed",
+				Err:  err,
+			}
+		}
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+
+	return &upload, nil
+}
+
+func (m *uploadRepository) GetByKeys(ctx context.Context, keys []string) ([]*models.Upload, error) {
+	ctx = metrics.WithOperation(ctx, "upload.GetByKeys")
+
+	cursor, err := m.collection.Find(ctx, bson.M{"key": bson.M{"$in": keys}})
+	if err != nil {
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+	defer cursor.Close(ctx)
+
+	var uploads []*models.Upload
+	if err := cursor.All(ctx, &uploads); err != nil {
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+
+	return uploads, nil
+}
+
+func (m *uploadRepository) SetThumbnails(ctx context.Context, key string, widths []int) error {
+	ctx = metrics.WithOperation(ctx, "upload.SetThumbnails")
+
+	update := bson.M{
+		"$set": bson.M{
+			"thumbnails": widths,
+		},
+	}
+
+	result, err := m.collection.UpdateOne(ctx, bson.M{"key": key}, update)
+	if err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+	if result.MatchedCount == 0 {
+		return &common.AppError{
+			Code: common.ErrNotFound,
+			Key:  "upload.not_confirmed",
+		}
+	}
+
+	return nil
+}
+
+func (m *uploadRepository) DeleteByKey(ctx context.Context, key string) (*models.Upload, error) {
+	ctx = metrics.WithOperation(ctx, "upload.DeleteByKey")
+
+	var upload models.Upload
+	err := m.collection.FindOneAndDelete(ctx, bson.M{"key": key}).Decode(&upload)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+	return &upload, nil
+}
+
+func (m *uploadRepository) GetPendingBefore(ctx context.Context, before time.Time) ([]*models.Upload, error) {
+	ctx = metrics.WithOperation(ctx, "upload.GetPendingBefore")
+
+	filter := bson.M{
+		"confirmedAt": nil,
+		"reservedAt":  bson.M{"$lt": before},
+	}
+	cursor, err := m.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+	defer cursor.Close(ctx)
+
+	var uploads []*models.Upload
+	if err := cursor.All(ctx, &uploads); err != nil {
+		return nil, common.ServerError(ctx, "common.database_error", err)
+	}
+
+	return uploads, nil
+}
+
+func (m *uploadRepository) GetUsage(ctx context.Context, userId string) (int64, int, error) {
+	ctx = metrics.WithOperation(ctx, "upload.GetUsage")
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"userId": userId, "confirmedAt": bson.M{"$ne": nil}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   nil,
+			"bytes": bson.M{"$sum": "$size"},
+			"count": bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := m.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, 0, common.ServerError(ctx, "common.database_error", err)
+	}
+	defer cursor.Close(ctx)
+
+	var result []struct {
+		Bytes int64 `bson:"bytes"`
+		Count int   `bson:"count"`
+	}
+	if err := cursor.All(ctx, &result); err != nil {
+		return 0, 0, common.ServerError(ctx, "common.database_error", err)
+	}
+
+	if len(result) == 0 {
+		return 0, 0, nil
+	}
+	return result[0].Bytes, result[0].Count, nil
+}
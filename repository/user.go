@@ -6,6 +6,7 @@ import (
 
 	"github.com/doyeon0307/tickit-backend/common"
 	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/metrics"
 	"github.com/doyeon0307/tickit-backend/models"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -24,12 +25,14 @@ func NewUserRepository(db *mongo.Database) domain.UserRepository {
 }
 
 func (m *userRepository) GetById(ctx context.Context, id string) (*models.User, error) {
+	ctx = metrics.WithOperation(ctx, "user.GetById")
+
 	objId, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return nil, &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "잘못된 아이디가 추출되었습니다. 토큰을 확인해주세요.",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "auth.user_id_invalid",
+			Err:  err,
 		}
 	}
 	var profile models.User
@@ -37,47 +40,37 @@ func (m *userRepository) GetById(ctx context.Context, id string) (*models.User,
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, &common.AppError{
-				Code:    common.ErrNotFound,
-				Message: "사용자가 존재하지 않습니다. 토큰을 확인해주세요.",
-				Err:     err,
+				Code: common.ErrNotFound,
+				Key:  "user.not_found",
+				Err:  err,
 			}
 		}
-		return nil, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return nil, common.ServerError(ctx, "common.database_error", err)
 	}
 
 	return &profile, nil
 }
 
 func (m *userRepository) Create(ctx context.Context, user *models.User) (string, error) {
+	ctx = metrics.WithOperation(ctx, "user.Create")
+
 	// oauthId가 이미 존재하는지 확인
 	exists, err := m.collection.CountDocuments(ctx, bson.M{"oauthId": user.OAuthId})
 	if err != nil {
-		return "", &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return "", common.ServerError(ctx, "common.database_error", err)
 	}
 
 	if exists > 0 {
 		return "", &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "이미 존재하는 사용자입니다. 로그인을 시도해주세요.",
-			Err:     err,
+			Code: common.ErrConflict,
+			Key:  "user.already_exists",
+			Err:  err,
 		}
 	}
 
 	result, err := m.collection.InsertOne(ctx, user)
 	if err != nil {
-		return "", &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return "", common.ServerError(ctx, "common.database_error", err)
 	}
 
 	user.Id = result.InsertedID.(primitive.ObjectID).Hex()
@@ -85,29 +78,27 @@ func (m *userRepository) Create(ctx context.Context, user *models.User) (string,
 }
 
 func (m *userRepository) Delete(ctx context.Context, id string) error {
+	ctx = metrics.WithOperation(ctx, "user.Delete")
+
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "잘못된 아이디가 추출되었습니다. 토큰을 확인해주세요.",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "auth.user_id_invalid",
+			Err:  err,
 		}
 	}
 
 	result, err := m.collection.DeleteOne(ctx, bson.M{"_id": objID})
 	if err != nil {
-		return &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return common.ServerError(ctx, "common.database_error", err)
 	}
 
 	if result.DeletedCount == 0 {
 		return &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "존재하지 않는 사용자는 삭제할 수 없습니다",
-			Err:     err,
+			Code: common.ErrNotFound,
+			Key:  "user.delete_not_found",
+			Err:  err,
 		}
 	}
 
@@ -115,32 +106,32 @@ func (m *userRepository) Delete(ctx context.Context, id string) error {
 }
 
 func (m *userRepository) GetByOAuthId(ctx context.Context, oauthId string) (*models.User, error) {
+	ctx = metrics.WithOperation(ctx, "user.GetByOAuthId")
+
 	var user models.User
 	err := m.collection.FindOne(ctx, bson.M{"oauthId": oauthId}).Decode(&user)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, &common.AppError{
-				Code:    common.ErrNotFound,
-				Message: "사용자를 찾을 수 없습니다",
-				Err:     err,
+				Code: common.ErrNotFound,
+				Key:  "user.not_registered",
+				Err:  err,
 			}
 		}
-		return nil, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "데이터베이스 오류가 발생했습니다",
-			Err:     err,
-		}
+		return nil, common.ServerError(ctx, "common.database_error", err)
 	}
 	return &user, nil
 }
 
 func (m *userRepository) SaveRefreshToken(ctx context.Context, userId string, refreshToken string, expiryTime time.Time) error {
+	ctx = metrics.WithOperation(ctx, "user.SaveRefreshToken")
+
 	objId, err := primitive.ObjectIDFromHex(userId)
 	if err != nil {
 		return &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "잘못된 아이디가 추출되었습니다. 토큰을 확인해주세요.",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "auth.user_id_invalid",
+			Err:  err,
 		}
 	}
 
@@ -154,23 +145,21 @@ func (m *userRepository) SaveRefreshToken(ctx context.Context, userId string, re
 
 	_, err = m.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
-		return &common.AppError{
-			Code:    common.ErrServer,
-			Message: "Refresh Token 저장에 실패했습니다",
-			Err:     err,
-		}
+		return common.ServerError(ctx, "auth.refresh_token_save_failed", err)
 	}
 
 	return nil
 }
 
 func (m *userRepository) GetRefreshToken(ctx context.Context, userId string) (string, error) {
+	ctx = metrics.WithOperation(ctx, "user.GetRefreshToken")
+
 	objId, err := primitive.ObjectIDFromHex(userId)
 	if err != nil {
 		return "", &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "잘못된 아이디가 추출되었습니다. 토큰을 확인해주세요.",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "auth.user_id_invalid",
+			Err:  err,
 		}
 	}
 
@@ -181,46 +170,40 @@ func (m *userRepository) GetRefreshToken(ctx context.Context, userId string) (st
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return "", &common.AppError{
-				Code:    common.ErrNotFound,
-				Message: "사용자가 존재하지 않습니다. 토큰을 확인해주세요.",
-				Err:     err,
+				Code: common.ErrNotFound,
+				Key:  "user.not_found",
+				Err:  err,
 			}
 		}
-		return "", &common.AppError{
-			Code:    common.ErrServer,
-			Message: "사용자 조회에 실패했습니다",
-			Err:     err,
-		}
+		return "", common.ServerError(ctx, "user.load_failed", err)
 	}
 
 	return user.RefreshToken, nil
 }
 
 func (m *userRepository) DeleteUser(ctx context.Context, userId string) error {
+	ctx = metrics.WithOperation(ctx, "user.DeleteUser")
+
 	objId, err := primitive.ObjectIDFromHex(userId)
 	if err != nil {
 		return &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "잘못된 아이디가 추출되었습니다. 토큰을 확인해주세요.",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "auth.user_id_invalid",
+			Err:  err,
 		}
 	}
 
 	filter := bson.M{"_id": objId}
 	result, err := m.collection.DeleteOne(ctx, filter)
 	if err != nil {
-		return &common.AppError{
-			Code:    common.ErrServer,
-			Message: "사용자 삭제에 실패했습니다",
-			Err:     err,
-		}
+		return common.ServerError(ctx, "user.delete_failed", err)
 	}
 
 	if result.DeletedCount == 0 {
 		return &common.AppError{
-			Code:    common.ErrNotFound,
-			Message: "사용자가 존재하지 않습니다. 토큰을 확인해주세요.",
-			Err:     err,
+			Code: common.ErrNotFound,
+			Key:  "user.not_found",
+			Err:  err,
 		}
 	}
 
@@ -228,12 +211,14 @@ func (m *userRepository) DeleteUser(ctx context.Context, userId string) error {
 }
 
 func (m *userRepository) RemoveRefreshToken(ctx context.Context, userId string) error {
+	ctx = metrics.WithOperation(ctx, "user.RemoveRefreshToken")
+
 	objId, err := primitive.ObjectIDFromHex(userId)
 	if err != nil {
 		return &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "잘못된 아이디가 추출되었습니다. 토큰을 확인해주세요.",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "auth.user_id_invalid",
+			Err:  err,
 		}
 	}
 
@@ -246,21 +231,179 @@ func (m *userRepository) RemoveRefreshToken(ctx context.Context, userId string)
 	}
 
 	result, err := m.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return common.ServerError(ctx, "auth.refresh_token_delete_failed", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return &common.AppError{
+			Code: common.ErrNotFound,
+			Key:  "user.not_found",
+			Err:  err,
+		}
+	}
+
+	return nil
+}
+
+func (m *userRepository) UpdateTimeZone(ctx context.Context, userId string, timeZone string) error {
+	ctx = metrics.WithOperation(ctx, "user.UpdateTimeZone")
+
+	objId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "auth.user_id_invalid",
+			Err:  err,
+		}
+	}
+
+	filter := bson.M{"_id": objId}
+	update := bson.M{
+		"$set": bson.M{
+			"timeZone": timeZone,
+		},
+	}
+
+	result, err := m.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return common.ServerError(ctx, "user.time_zone_save_failed", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return &common.AppError{
+			Code: common.ErrNotFound,
+			Key:  "user.not_found",
+			Err:  err,
+		}
+	}
+
+	return nil
+}
+
+func (m *userRepository) UpdateLocale(ctx context.Context, userId string, locale string) error {
+	ctx = metrics.WithOperation(ctx, "user.UpdateLocale")
+
+	objId, err := primitive.ObjectIDFromHex(userId)
 	if err != nil {
 		return &common.AppError{
-			Code:    common.ErrServer,
-			Message: "Refresh Token 삭제에 실패했습니다",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "auth.user_id_invalid",
+			Err:  err,
 		}
 	}
 
+	filter := bson.M{"_id": objId}
+	update := bson.M{
+		"$set": bson.M{
+			"locale": locale,
+		},
+	}
+
+	result, err := m.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return common.ServerError(ctx, "user.locale_save_failed", err)
+	}
+
 	if result.MatchedCount == 0 {
 		return &common.AppError{
-			Code:    common.ErrNotFound,
-			Message: "사용자가 존재하지 않습니다. 토큰을 확인해주세요.",
-			Err:     err,
+			Code: common.ErrNotFound,
+			Key:  "user.not_found",
+			Err:  err,
 		}
 	}
 
 	return nil
 }
+
+func (m *userRepository) Count(ctx context.Context) (int64, error) {
+	ctx = metrics.WithOperation(ctx, "user.Count")
+
+	count, err := m.collection.EstimatedDocumentCount(ctx)
+	if err != nil {
+		return 0, common.ServerError(ctx, "common.database_error", err)
+	}
+	return count, nil
+}
+
+func (m *userRepository) InitStorageUsage(ctx context.Context, userId string, used int64) error {
+	ctx = metrics.WithOperation(ctx, "user.InitStorageUsage")
+
+	objId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "auth.user_id_invalid",
+			Err:  err,
+		}
+	}
+
+	// 동시에 여러 요청이 채우려 해도 먼저 채운 값을 덮어쓰지 않습니다
+	filter := bson.M{"_id": objId, "storageUsedBytes": bson.M{"$exists": false}}
+	update := bson.M{
+		"$set": bson.M{
+			"storageUsedBytes": used,
+		},
+	}
+
+	if _, err := m.collection.UpdateOne(ctx, filter, update); err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+	return nil
+}
+
+func (m *userRepository) ReserveStorage(ctx context.Context, userId string, size, quota int64) (bool, error) {
+	ctx = metrics.WithOperation(ctx, "user.ReserveStorage")
+
+	objId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return false, &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "auth.user_id_invalid",
+			Err:  err,
+		}
+	}
+	if size > quota {
+		return false, nil
+	}
+
+	// 더한 뒤에도 quota를 넘지 않는 경우에만 더하므로 동시에 예약해도 합계가 quota를 넘지 않습니다
+	filter := bson.M{"_id": objId, "storageUsedBytes": bson.M{"$lte": quota - size}}
+	update := bson.M{
+		"$inc": bson.M{
+			"storageUsedBytes": size,
+		},
+	}
+
+	result, err := m.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, common.ServerError(ctx, "common.database_error", err)
+	}
+	return result.MatchedCount > 0, nil
+}
+
+func (m *userRepository) ReleaseStorage(ctx context.Context, userId string, size int64) error {
+	ctx = metrics.WithOperation(ctx, "user.ReleaseStorage")
+
+	objId, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "auth.user_id_invalid",
+			Err:  err,
+		}
+	}
+
+	// 사용량이 음수가 되지 않도록 0에서 멈춥니다. 아직 채워지지 않은 사용량은 건드리지 않습니다.
+	filter := bson.M{"_id": objId, "storageUsedBytes": bson.M{"$exists": true}}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"storageUsedBytes": bson.M{"$max": bson.A{0, bson.M{"$subtract": bson.A{"$storageUsedBytes", size}}}},
+		}}},
+	}
+
+	if _, err := m.collection.UpdateOne(ctx, filter, update); err != nil {
+		return common.ServerError(ctx, "common.database_error", err)
+	}
+	return nil
+}
@@ -1,25 +1,78 @@
 package routes
 
 import (
+	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/doyeon0307/tickit-backend/config"
 	"github.com/doyeon0307/tickit-backend/domain"
 	"github.com/doyeon0307/tickit-backend/handler"
+	"github.com/doyeon0307/tickit-backend/health"
+	"github.com/doyeon0307/tickit-backend/ratelimit"
 	"github.com/doyeon0307/tickit-backend/service"
+	"github.com/doyeon0307/tickit-backend/storage"
+	"github.com/doyeon0307/tickit-backend/tracing"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 type HandlerContainer struct {
-	TicketUsecase   domain.TicketUsecase
-	ScheduleUsecase domain.ScheduleUsecase
-	UserUsecase     domain.UserUsecase
-	S3Config        config.S3Config
+	TicketUsecase      domain.TicketUsecase
+	TicketDraftUsecase domain.TicketDraftUsecase
+	ScheduleUsecase    domain.ScheduleUsecase
+	UserUsecase        domain.UserUsecase
+	UploadUsecase      domain.UploadUsecase
+	JWT                *service.JWTManager
+	Health             *health.Registry
+	// LocalStorage는 로컬 저장소를 사용할 때만 설정되며, 서명된 URL을 처리하는 경로를 등록합니다
+	LocalStorage *storage.LocalStorage
+	// RateLimit이 nil이면 요청을 제한하지 않습니다
+	RateLimit      ratelimit.Store
+	TrustedProxies []string
+}
+
+// authRateLimits는 /api/auth 아래 로그인 전 요청을 클라이언트 IP별로 제한합니다.
+// 통신사 NAT 뒤의 사용자들은 IP를 나눠 쓰므로 한 사람이 쓰기에는 넉넉하게 잡습니다.
+var authRateLimits = ratelimit.Rules{
+	Default: ratelimit.Policy{Name: "auth", Limit: 120, Period: time.Minute, Burst: 60},
+	Routes: map[string]ratelimit.Policy{
+		"POST /api/auth/kakao/login":    {Name: "login", Limit: 30, Period: time.Minute, Burst: 20},
+		"POST /api/auth/kakao/register": {Name: "register", Limit: 10, Period: time.Minute, Burst: 10},
+		"POST /api/auth/refresh":        {Name: "refresh", Limit: 30, Period: time.Minute, Burst: 20},
+	},
+}
+
+// userRateLimits는 로그인한 사용자의 요청을 사용자별로 제한합니다.
+// 저장소 URL 발급, 업로드, 글자 인식처럼 비용이 큰 요청은 따로 더 좁게 제한합니다.
+var userRateLimits = ratelimit.Rules{
+	Default: ratelimit.Policy{Name: "api", Limit: 300, Period: time.Minute, Burst: 100},
+	Routes: map[string]ratelimit.Policy{
+		"GET /api/s3/presigned-url":          {Name: "presigned-url", Limit: 30, Period: time.Minute, Burst: 10},
+		"POST /api/images":                   {Name: "upload", Limit: 30, Period: time.Minute, Burst: 10},
+		"GET /api/images/colors":             {Name: "colors", Limit: 30, Period: time.Minute, Burst: 10},
+		"POST /api/tickets/draft-from-image": {Name: "ocr", Limit: 10, Period: time.Minute, Burst: 3},
+	},
 }
 
 func SetupRouter(handlers HandlerContainer) *gin.Engine {
-	router := gin.Default()
+	router := gin.New()
+	// 핸들러가 *gin.Context를 그대로 context.Context로 넘겨도 요청 ID를 찾을 수 있게 합니다
+	router.ContextWithFallback = true
+	if err := router.SetTrustedProxies(handlers.TrustedProxies); err != nil {
+		slog.Warn("신뢰할 프록시 설정에 실패했습니다", "error", err)
+	}
+	router.Use(
+		otelgin.Middleware(tracing.ServiceName),
+		service.RequestIDMiddleware(),
+		service.LocaleMiddleware(),
+		service.AccessLogMiddleware(),
+		service.MetricsMiddleware(),
+		service.ErrorMiddleware(),
+		service.RecoveryMiddleware(),
+	)
+	router.NoRoute(service.NotFoundHandler)
 
 	config.SetUpSwagger(router)
 
@@ -29,26 +82,34 @@ func SetupRouter(handlers HandlerContainer) *gin.Engine {
 		})
 	})
 
+	handler.NewHealthHandler(router, handlers.Health)
+
+	if handlers.LocalStorage != nil {
+		handler.NewStorageHandler(router, handlers.LocalStorage)
+	}
+
 	v1 := router.Group("/api")
 	{
-		v1.GET("/health", healthCheck)
-
-		handler.NewUserHandler(v1, handlers.UserUsecase)
+		auth := v1.Group("")
+		if handlers.RateLimit != nil {
+			auth.Use(service.RateLimitMiddleware(handlers.RateLimit, authRateLimits, service.IPKey))
+		}
 
+		// 제한을 넘은 요청이 사용자 언어를 읽지 않도록 요청 제한 뒤에 언어를 적용합니다.
+		// 따라서 요청 제한 응답은 Accept-Language의 언어를 따릅니다.
 		authorized := v1.Group("")
-		authorized.Use(service.AuthMiddleware())
+		authorized.Use(service.AuthMiddleware(handlers.JWT))
+		if handlers.RateLimit != nil {
+			authorized.Use(service.RateLimitMiddleware(handlers.RateLimit, userRateLimits, service.UserKey))
+		}
+		authorized.Use(service.UserLocaleMiddleware(handlers.UserUsecase.GetLocale))
 		{
-			handler.NewTicketHandler(authorized, handlers.TicketUsecase)
+			handler.NewUserHandler(auth, authorized, handlers.UserUsecase, handlers.JWT)
+			handler.NewTicketHandler(authorized, handlers.TicketUsecase, handlers.TicketDraftUsecase)
 			handler.NewScheduleHandler(authorized, handlers.ScheduleUsecase)
-			handler.NewS3Handler(authorized, &handlers.S3Config)
+			handler.NewS3Handler(authorized, handlers.UploadUsecase)
 		}
 	}
 
 	return router
 }
-
-func healthCheck(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{
-		"status": "ok",
-	})
-}
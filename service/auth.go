@@ -1,48 +1,34 @@
 package service
 
 import (
-	"net/http"
 	"strings"
 
 	"github.com/doyeon0307/tickit-backend/common"
 	"github.com/gin-gonic/gin"
 )
 
-func AuthMiddleware() gin.HandlerFunc {
+func AuthMiddleware(jwt *JWTManager) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, common.Error(
-				http.StatusUnauthorized,
-				"Authorization 헤더를 찾을 수 없습니다",
-			))
+			_ = c.Error(common.NewError(common.ErrUnauthorized, "auth.header_missing", nil))
 			c.Abort()
 			return
 		}
 
 		parts := strings.Split(authHeader, " ")
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			c.JSON(http.StatusUnauthorized, common.Error(
-				http.StatusUnauthorized,
-				"헤더에서 토큰을 찾을 수 없습니다. Bearer + 토큰 형태로 입력해주세요.",
-			))
+			_ = c.Error(common.NewError(common.ErrUnauthorized, "auth.bearer_missing", nil))
 			c.Abort()
 			return
 		}
 
-		userId, err := ValidateToken(parts[1])
+		userId, err := jwt.ValidateToken(parts[1])
 		if err != nil {
-			if appErr, ok := err.(*common.AppError); ok {
-				c.JSON(appErr.Code.StatusCode(), common.Error(
-					appErr.Code.StatusCode(),
-					appErr.Message,
-				))
-			} else {
-				c.JSON(http.StatusUnauthorized, common.Error(
-					http.StatusUnauthorized,
-					"토큰 검증에 실패했습니다",
-				))
+			if _, ok := common.AsAppError(err); !ok {
+				err = common.NewError(common.ErrUnauthorized, "auth.token_verify_failed", err)
 			}
+			_ = c.Error(err)
 			c.Abort()
 			return
 		}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"github.com/gin-gonic/gin"
+)
+
+const problemContentType = "application/problem+json"
+
+// ErrorMiddleware는 핸들러와 미들웨어가 c.Error로 넘긴 오류를 응답으로 만듭니다.
+// 응답 형식은 common.Response이며, Accept 헤더에 application/problem+json이 있으면 RFC 7807 형식으로 보냅니다.
+func ErrorMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+		writeError(c, c.Errors.Last().Err)
+	}
+}
+
+// NotFoundHandler는 없는 경로에 대한 요청도 같은 형식의 오류로 응답합니다
+func NotFoundHandler(c *gin.Context) {
+	_ = c.Error(common.NewError(common.ErrNotFound, "common.route_not_found", nil))
+}
+
+func writeError(c *gin.Context, err error) {
+	appErr, ok := common.AsAppError(err)
+	if !ok {
+		// AppError가 아닌 오류는 내용을 사용자에게 보여주지 않고 로그에만 남깁니다
+		slog.ErrorContext(c, "처리되지 않은 오류", "error", err)
+		appErr = &common.AppError{
+			Code: common.ErrServer,
+			Key:  "common.server_error",
+			Err:  err,
+		}
+	}
+
+	status := appErr.Code.StatusCode()
+	if strings.Contains(c.GetHeader("Accept"), problemContentType) {
+		c.Header("Content-Type", problemContentType)
+		c.JSON(status, common.Problem(c, appErr, c.Request.URL.Path))
+		return
+	}
+	c.JSON(status, common.Error(c, appErr))
+}
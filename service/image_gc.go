@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/metrics"
+	"github.com/doyeon0307/tickit-backend/utils"
+)
+
+// ImageGCReport는 이미지 정리 작업 한 번의 결과입니다
+type ImageGCReport struct {
+	StartedAt  time.Time     `json:"startedAt"`
+	Duration   time.Duration `json:"duration"`
+	DryRun     bool          `json:"dryRun"`
+	Scanned    int           `json:"scanned"`
+	Referenced int           `json:"referenced"`
+	InGrace    int           `json:"inGrace"`
+	Orphaned   int           `json:"orphaned"`
+	Deleted    int           `json:"deleted"`
+	FreedBytes int64         `json:"freedBytes"`
+	Failed     int           `json:"failed"`
+	// Expired는 URL만 발급받고 파일이 올라오지 않아 예약을 돌려놓은 업로드 수입니다
+	Expired int    `json:"expired"`
+	Error   string `json:"error,omitempty"`
+}
+
+// ImageGC는 티켓과 일정 어디에서도 참조하지 않는 이미지를 주기적으로 지웁니다.
+// 업로드 직후 아직 티켓에 연결되지 않은 이미지를 지우지 않도록 gracePeriod보다 오래된 객체만 대상으로 하며,
+// 원본이 참조되는 한 그 썸네일도 남겨 둡니다. 지운 원본과 gracePeriod가 지나도록 파일이 올라오지 않은 업로드의
+// 예약 저장 공간은 사용자에게 돌려놓습니다. dryRun이면 지울 대상을 집계만 합니다.
+type ImageGC struct {
+	storage      domain.ObjectStorage
+	ticketRepo   domain.TicketRepository
+	scheduleRepo domain.ScheduleRepository
+	uploadRepo   domain.UploadRepository
+	userRepo     domain.UserRepository
+	interval     time.Duration
+	gracePeriod  time.Duration
+	dryRun       bool
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewImageGC(storage domain.ObjectStorage, ticketRepo domain.TicketRepository, scheduleRepo domain.ScheduleRepository, uploadRepo domain.UploadRepository, userRepo domain.UserRepository, interval, gracePeriod time.Duration, dryRun bool) *ImageGC {
+	return &ImageGC{
+		storage:      storage,
+		ticketRepo:   ticketRepo,
+		scheduleRepo: scheduleRepo,
+		uploadRepo:   uploadRepo,
+		userRepo:     userRepo,
+		interval:     interval,
+		gracePeriod:  gracePeriod,
+		dryRun:       dryRun,
+	}
+}
+
+// Start는 시작 직후와 이후 interval마다 정리 작업을 수행하는 고루틴을 시작합니다.
+// 서버가 interval보다 자주 재시작되어도 정리가 밀리지 않도록 시작할 때 한 번 수행합니다.
+func (g *ImageGC) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	g.cancel = cancel
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+
+		g.Run(ctx)
+
+		ticker := time.NewTicker(g.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				g.Run(ctx)
+			}
+		}
+	}()
+}
+
+// Stop은 진행 중인 정리 작업을 중단하고 고루틴이 끝날 때까지 기다립니다
+func (g *ImageGC) Stop() {
+	if g.cancel != nil {
+		g.cancel()
+	}
+	g.wg.Wait()
+}
+
+// Run은 정리 작업을 한 번 수행하고 결과를 지표에 반영합니다
+func (g *ImageGC) Run(ctx context.Context) *ImageGCReport {
+	report := &ImageGCReport{
+		StartedAt: time.Now(),
+		DryRun:    g.dryRun,
+	}
+
+	err := g.collect(ctx, report)
+	report.Duration = time.Since(report.StartedAt)
+	if err != nil {
+		report.Error = err.Error()
+		slog.ErrorContext(ctx, "이미지 정리 실패", "error", err)
+	} else {
+		slog.InfoContext(ctx, "이미지 정리 완료",
+			"dryRun", report.DryRun,
+			"scanned", report.Scanned,
+			"referenced", report.Referenced,
+			"inGrace", report.InGrace,
+			"orphaned", report.Orphaned,
+			"deleted", report.Deleted,
+			"freedBytes", report.FreedBytes,
+			"failed", report.Failed,
+			"expired", report.Expired,
+			"duration", report.Duration.String(),
+		)
+	}
+
+	metrics.ImageGCRun(err == nil, report.Deleted, report.Failed, report.FreedBytes)
+	return report
+}
+
+func (g *ImageGC) collect(ctx context.Context, report *ImageGCReport) error {
+	referenced, err := g.referencedKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	objects, err := g.storage.List(ctx, utils.ImageKeyRoot)
+	if err != nil {
+		return err
+	}
+
+	cutoff := report.StartedAt.Add(-g.gracePeriod)
+	listed := make(map[string]bool, len(objects))
+	for _, object := range objects {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		report.Scanned++
+		listed[object.Key] = true
+
+		if referenced[object.Key] {
+			report.Referenced++
+			continue
+		}
+		if object.LastModified.After(cutoff) {
+			report.InGrace++
+			continue
+		}
+
+		report.Orphaned++
+		if g.dryRun {
+			continue
+		}
+		if err := g.storage.Delete(ctx, object.Key); err != nil {
+			report.Failed++
+			slog.WarnContext(ctx, "이미지 삭제 실패", "key", object.Key, "error", err)
+			continue
+		}
+		// 썸네일은 업로드 기록이 없으므로 원본일 때만 지워집니다
+		g.release(ctx, object.Key)
+		report.Deleted++
+		report.FreedBytes += object.Size
+	}
+
+	return g.expireReservations(ctx, cutoff, listed, report)
+}
+
+// expireReservations는 cutoff 전에 URL을 발급받았지만 파일이 올라오지 않은 업로드의 예약을 돌려놓습니다.
+// 파일이 있는 업로드는 위에서 파일과 함께 정리되므로 listed에 없는 것만 대상으로 합니다.
+func (g *ImageGC) expireReservations(ctx context.Context, cutoff time.Time, listed map[string]bool, report *ImageGCReport) error {
+	pending, err := g.uploadRepo.GetPendingBefore(ctx, cutoff)
+	if err != nil {
+		return fmt.Errorf("예약된 업로드 조회 실패: %v", err)
+	}
+
+	for _, upload := range pending {
+		if listed[upload.Key] {
+			continue
+		}
+		report.Expired++
+		if g.dryRun {
+			continue
+		}
+		g.release(ctx, upload.Key)
+	}
+	return nil
+}
+
+// release는 key의 업로드 기록을 지우고 그 크기만큼 사용자의 저장 공간을 돌려놓습니다
+func (g *ImageGC) release(ctx context.Context, key string) {
+	upload, err := g.uploadRepo.DeleteByKey(ctx, key)
+	if err != nil || upload == nil {
+		return
+	}
+	if err := g.userRepo.ReleaseStorage(ctx, upload.UserId, upload.Size); err != nil {
+		slog.WarnContext(ctx, "저장 공간 반환 실패", "userId", upload.UserId, "size", upload.Size, "error", err)
+	}
+}
+
+// referencedKeys는 티켓과 일정이 참조하는 이미지 키와 그 썸네일 키입니다
+func (g *ImageGC) referencedKeys(ctx context.Context) (map[string]bool, error) {
+	referenced := make(map[string]bool)
+	visit := func(image string) {
+		key := utils.ImageKey(image)
+		if referenced[key] {
+			return
+		}
+		referenced[key] = true
+		for _, width := range utils.ThumbnailWidths {
+			referenced[utils.ThumbnailKey(key, width, ".jpg")] = true
+			referenced[utils.ThumbnailKey(key, width, ".webp")] = true
+		}
+	}
+
+	if err := g.ticketRepo.ForEachImage(ctx, visit); err != nil {
+		return nil, fmt.Errorf("티켓 이미지 조회 실패: %v", err)
+	}
+	if err := g.scheduleRepo.ForEachImage(ctx, visit); err != nil {
+		return nil, fmt.Errorf("일정 이미지 조회 실패: %v", err)
+	}
+	return referenced, nil
+}
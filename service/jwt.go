@@ -1,7 +1,7 @@
 package service
 
 import (
-	"os"
+	"context"
 	"time"
 
 	"github.com/doyeon0307/tickit-backend/common"
@@ -13,14 +13,23 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
-var secretKey = os.Getenv("JWT_SECRET_KEY")
-
 const (
 	accessTokenDuration  = 30 * time.Minute
 	refreshTokenDuration = 30 * 24 * time.Hour
 )
 
-func GenerateAccessToken(userId string) (string, error) {
+// JWTManager는 액세스 토큰과 리프레시 토큰을 발급하고 검증합니다
+type JWTManager struct {
+	secretKey []byte
+}
+
+func NewJWTManager(secretKey string) *JWTManager {
+	return &JWTManager{
+		secretKey: []byte(secretKey),
+	}
+}
+
+func (m *JWTManager) GenerateAccessToken(userId string) (string, error) {
 	claims := Claims{
 		userId,
 		jwt.RegisteredClaims{
@@ -30,19 +39,15 @@ func GenerateAccessToken(userId string) (string, error) {
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	accessToken, err := token.SignedString([]byte(secretKey))
+	accessToken, err := token.SignedString(m.secretKey)
 	if err != nil {
-		return "", &common.AppError{
-			Code:    common.ErrServer,
-			Message: "토큰 생성에 실패했습니다",
-			Err:     err,
-		}
+		return "", common.ServerError(context.Background(), "auth.token_failed", err)
 	}
 
 	return accessToken, nil
 }
 
-func GenerateRefreshToken(userId string) (string, time.Time, error) {
+func (m *JWTManager) GenerateRefreshToken(userId string) (string, time.Time, error) {
 	expiryTime := time.Now().Add(refreshTokenDuration)
 	claims := Claims{
 		userId,
@@ -53,43 +58,39 @@ func GenerateRefreshToken(userId string) (string, time.Time, error) {
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	refreshToken, err := token.SignedString([]byte(secretKey))
+	refreshToken, err := token.SignedString(m.secretKey)
 	if err != nil {
-		return "", time.Time{}, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "토큰 생성에 실패했습니다",
-			Err:     err,
-		}
+		return "", time.Time{}, common.ServerError(context.Background(), "auth.token_failed", err)
 	}
 
 	return refreshToken, expiryTime, nil
 }
 
-func ValidateToken(tokenString string) (string, error) {
+func (m *JWTManager) ValidateToken(tokenString string) (string, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(secretKey), nil
+		return m.secretKey, nil
 	})
 
 	if err != nil {
 		if err == jwt.ErrSignatureInvalid {
 			return "", &common.AppError{
-				Code:    common.ErrUnauthorized,
-				Message: "유효하지 않은 토큰입니다",
-				Err:     err,
+				Code: common.ErrUnauthorized,
+				Key:  "auth.token_invalid",
+				Err:  err,
 			}
 		}
 		ve, ok := err.(*jwt.ValidationError)
 		if ok && ve.Errors == jwt.ValidationErrorExpired {
 			return "", &common.AppError{
-				Code:    common.ErrUnauthorized,
-				Message: "토큰이 만료되었습니다",
-				Err:     err,
+				Code: common.ErrUnauthorized,
+				Key:  "auth.token_expired",
+				Err:  err,
 			}
 		}
 		return "", &common.AppError{
-			Code:    common.ErrUnauthorized,
-			Message: "토큰 검증에 실패했습니다",
-			Err:     err,
+			Code: common.ErrUnauthorized,
+			Key:  "auth.token_verify_failed",
+			Err:  err,
 		}
 	}
 
@@ -98,7 +99,7 @@ func ValidateToken(tokenString string) (string, error) {
 	}
 
 	return "", &common.AppError{
-		Code:    common.ErrUnauthorized,
-		Message: "토큰 검증에 실패했습니다",
+		Code: common.ErrUnauthorized,
+		Key:  "auth.token_verify_failed",
 	}
 }
@@ -1,17 +1,27 @@
 package service
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/doyeon0307/tickit-backend/common"
 	"github.com/doyeon0307/tickit-backend/dto"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
+// kakaoClient는 카카오 API 호출에 사용합니다. 요청 컨텍스트가 끝나지 않아도 응답이 늦으면 포기합니다.
+var kakaoClient = &http.Client{
+	Timeout:   10 * time.Second,
+	Transport: otelhttp.NewTransport(http.DefaultTransport),
+}
+
 // KakaoUserResponse는 실제 카카오 API 응답 구조체입니다
 type KakaoUserResponse struct {
 	NickName        string `json:"nickName"`
@@ -19,65 +29,51 @@ type KakaoUserResponse struct {
 	ThumbnailURL    string `json:"thumbnailURL"`
 }
 
-func GetUserInfoFromKakao(accessToken string) (*dto.KakaoProfile, error) {
-	req, err := http.NewRequest("GET", "https://kapi.kakao.com/v1/api/talk/profile", nil)
+func GetUserInfoFromKakao(ctx context.Context, accessToken string) (*dto.KakaoProfile, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://kapi.kakao.com/v1/api/talk/profile", nil)
 	if err != nil {
-		return nil, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "Request 생성 실패",
-			Err:     err,
-		}
+		return nil, common.ServerError(ctx, "kakao.request_failed", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+accessToken)
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded;charset=utf-8")
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := kakaoClient.Do(req)
 	if err != nil {
-		return nil, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "HTTP 요청 실패",
-			Err:     err,
-		}
+		return nil, common.ServerError(ctx, "kakao.http_failed", err)
 	}
 	defer resp.Body.Close()
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "응답 바디 읽기 실패",
-			Err:     err,
-		}
+		return nil, common.ServerError(ctx, "kakao.read_failed", err)
 	}
 
-	// Debug: 응답 로깅
-	fmt.Printf("Kakao API Response Status: %d\n", resp.StatusCode)
-	fmt.Printf("Kakao API Response Body: %s\n", string(body))
-
 	if resp.StatusCode != http.StatusOK {
+		// 카카오의 응답 본문은 클라이언트에 보내지 않고 로그에만 남깁니다
+		err := fmt.Errorf("카카오 API 응답 (상태 코드: %d): %s", resp.StatusCode, string(body))
+		slog.WarnContext(ctx, "카카오 사용자 정보를 불러오지 못했습니다", "error", err)
 		return nil, &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: fmt.Sprintf("카카오 API 오류 (상태 코드: %d): %s", resp.StatusCode, string(body)),
-			Err:     fmt.Errorf("카카오 API 응답: %s", string(body)),
+			Code: common.ErrBadRequest,
+			Key:  "kakao.api_error",
+			Err:  err,
 		}
 	}
 
 	var kakaoResp KakaoUserResponse
 	if err := json.Unmarshal(body, &kakaoResp); err != nil {
 		return nil, &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "카카오 응답 파싱 실패",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "kakao.response_invalid",
+			Err:  err,
 		}
 	}
 
 	if kakaoResp.NickName == "" {
 		return nil, &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "사용자 닉네임을 찾을 수 없습니다",
-			Err:     fmt.Errorf("empty nickname in response"),
+			Code: common.ErrBadRequest,
+			Key:  "kakao.nickname_missing",
+			Err:  fmt.Errorf("empty nickname in response"),
 		}
 	}
 
@@ -90,17 +86,17 @@ func GetOAuthIdFromKakao(idToken string) (string, error) {
 	parts := strings.Split(idToken, ".")
 	if len(parts) != 3 {
 		return "", &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "ID Token의 형식이 잘못되었습니다",
+			Code: common.ErrBadRequest,
+			Key:  "kakao.id_token_format",
 		}
 	}
 
 	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
 	if err != nil {
 		return "", &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "ID Token 디코딩에 실패했습니다",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "kakao.id_token_decode_failed",
+			Err:  err,
 		}
 	}
 
@@ -109,16 +105,16 @@ func GetOAuthIdFromKakao(idToken string) (string, error) {
 	}
 	if err := json.Unmarshal(payload, &claims); err != nil {
 		return "", &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "ID Token 처리에 실패했습니다",
-			Err:     err,
+			Code: common.ErrBadRequest,
+			Key:  "kakao.id_token_parse_failed",
+			Err:  err,
 		}
 	}
 
 	if claims.Sub == "" {
 		return "", &common.AppError{
-			Code:    common.ErrBadRequest,
-			Message: "ID Token에서 OAuthId를 찾을 수 없습니다",
+			Code: common.ErrBadRequest,
+			Key:  "kakao.oauth_id_missing",
 		}
 	}
 
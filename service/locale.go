@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/doyeon0307/tickit-backend/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+// LocaleMiddleware는 Accept-Language 헤더로 응답 언어를 정해 요청 컨텍스트에 담습니다.
+// 지원하는 언어가 없으면 기본 언어(한국어)를 사용하며, 정한 언어는 Content-Language 헤더로 알려줍니다.
+func LocaleMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		locale, _ := i18n.FromAcceptLanguage(c.GetHeader("Accept-Language"))
+		SetLocale(c, locale)
+		c.Next()
+	}
+}
+
+// UserLocaleMiddleware는 AuthMiddleware 뒤에서 사용자가 설정한 언어를 적용합니다.
+// 앱에서 직접 고른 언어이므로 기기 언어를 따르는 Accept-Language보다 우선합니다.
+func UserLocaleMiddleware(userLocale func(ctx context.Context, userId string) (i18n.Locale, bool, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		locale, ok, err := userLocale(c.Request.Context(), c.GetString("userId"))
+		if err != nil {
+			// 설정을 불러오지 못해도 요청은 Accept-Language의 언어로 처리합니다
+			slog.WarnContext(c, "사용자 언어 조회 실패", "error", err)
+		}
+		if ok {
+			SetLocale(c, locale)
+		}
+		c.Next()
+	}
+}
+
+// SetLocale은 이후 응답에 사용할 언어를 바꿉니다
+func SetLocale(c *gin.Context, locale i18n.Locale) {
+	c.Request = c.Request.WithContext(i18n.WithLocale(c.Request.Context(), locale))
+	c.Header("Content-Language", string(locale))
+}
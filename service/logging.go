@@ -0,0 +1,87 @@
+package service
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"runtime/debug"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"github.com/doyeon0307/tickit-backend/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+// 클라이언트나 프록시가 보낸 요청 ID는 로그에 그대로 남으므로 짧고 안전한 문자만 받습니다
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestIDMiddleware는 요청마다 ID를 정해 요청 컨텍스트와 X-Request-ID 응답 헤더에 담습니다.
+// 요청에 올바른 X-Request-ID가 있으면 그 값을 이어서 사용합니다.
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !requestIDPattern.MatchString(id) {
+			id = uuid.NewString()
+		}
+
+		c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), id))
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+// AccessLogMiddleware는 요청마다 경로, 상태 코드, 처리 시간을 한 줄로 기록합니다.
+// 경로는 등록된 패턴(/api/tickets/:id)으로 남겨 ID나 쿼리의 서명 값이 로그에 섞이지 않게 합니다.
+func AccessLogMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		attrs := []slog.Attr{
+			slog.String("method", c.Request.Method),
+			slog.String("route", route),
+			slog.Int("status", status),
+			slog.Float64("latencyMs", float64(time.Since(start).Microseconds())/1000),
+			slog.Int("bytes", max(c.Writer.Size(), 0)),
+			slog.String("clientIp", c.ClientIP()),
+		}
+		if userId := c.GetString("userId"); userId != "" {
+			attrs = append(attrs, slog.String("userId", userId))
+		}
+		slog.LogAttrs(c.Request.Context(), level, "요청 처리", attrs...)
+	}
+}
+
+// RecoveryMiddleware는 처리 중 패닉이 나면 스택과 함께 로그를 남기고 ErrorMiddleware가 500 응답을 보내게 합니다
+func RecoveryMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				slog.ErrorContext(c.Request.Context(), "요청 처리 중 패닉",
+					"error", fmt.Sprint(recovered),
+					"stack", string(debug.Stack()),
+				)
+				_ = c.Error(common.NewError(common.ErrServer, "common.server_error", fmt.Errorf("panic: %v", recovered)))
+				c.Abort()
+			}
+		}()
+		c.Next()
+	}
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+// MetricsMiddleware는 요청 수와 처리 시간을 경로 패턴과 상태 코드별로 집계합니다
+func MetricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		metrics.ObserveRequest(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
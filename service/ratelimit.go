@@ -0,0 +1,54 @@
+package service
+
+import (
+	"log/slog"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"github.com/doyeon0307/tickit-backend/metrics"
+	"github.com/doyeon0307/tickit-backend/ratelimit"
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimitMiddleware는 key가 같은 요청을 경로별 정책에 따라 제한합니다.
+// 허용한 요청과 거부한 요청 모두 X-RateLimit-* 헤더로 남은 한도를 알려주고, 거부한 요청에는 Retry-After를 보냅니다.
+func RateLimitMiddleware(store ratelimit.Store, rules ratelimit.Rules, key func(c *gin.Context) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		policy := rules.For(c.Request.Method, c.FullPath())
+		result, err := store.Take(c.Request.Context(), policy.Name+":"+key(c), policy)
+		if err != nil {
+			// 제한 저장소에 문제가 있어도 서비스는 계속 사용할 수 있게 요청을 통과시킵니다
+			slog.WarnContext(c, "요청 제한 확인 실패", "policy", policy.Name, "error", err)
+			c.Next()
+			return
+		}
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("X-RateLimit-Reset", ceilSeconds(result.ResetAfter))
+		if !result.Allowed {
+			metrics.RateLimited(policy.Name)
+			c.Header("Retry-After", ceilSeconds(result.RetryAfter))
+			_ = c.Error(common.NewError(common.ErrRateLimited, "request.rate_limited", nil))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// UserKey는 AuthMiddleware 뒤에서 사용자별로 제한합니다
+func UserKey(c *gin.Context) string {
+	return "user:" + c.GetString("userId")
+}
+
+// IPKey는 로그인 전 요청을 클라이언트 IP별로 제한합니다
+func IPKey(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png"
+	"log/slog"
+	"sync"
+
+	"github.com/HugoSmits86/nativewebp"
+	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/utils"
+	_ "golang.org/x/image/webp"
+)
+
+const thumbnailJPEGQuality = 80
+
+type thumbnailJob struct {
+	key         string
+	contentType string
+}
+
+// ThumbnailWorker는 확인된 업로드의 썸네일을 서버 안의 고정된 수의 고루틴에서 생성합니다.
+// 썸네일은 utils.ThumbnailWidths 크기마다 JPEG와 WebP로 저장됩니다.
+// HEIC/HEIF는 디코딩할 수 없으므로 썸네일 없이 원본을 그대로 사용합니다.
+type ThumbnailWorker struct {
+	storage    domain.ObjectStorage
+	uploadRepo domain.UploadRepository
+	jobs       chan thumbnailJob
+	wg         sync.WaitGroup
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func NewThumbnailWorker(storage domain.ObjectStorage, uploadRepo domain.UploadRepository, workers, queueSize int) *ThumbnailWorker {
+	w := &ThumbnailWorker{
+		storage:    storage,
+		uploadRepo: uploadRepo,
+		jobs:       make(chan thumbnailJob, queueSize),
+	}
+
+	for i := 0; i < workers; i++ {
+		w.wg.Add(1)
+		go w.run()
+	}
+	return w
+}
+
+// Enqueue는 썸네일 생성을 예약합니다. 대기열이 가득 찼거나 종료 중이면 false를 반환하며, 이 경우 원본 이미지가 사용됩니다.
+func (w *ThumbnailWorker) Enqueue(key, contentType string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return false
+	}
+	select {
+	case w.jobs <- thumbnailJob{key: key, contentType: contentType}:
+		return true
+	default:
+		slog.Warn("썸네일 대기열이 가득 찼습니다", "key", key)
+		return false
+	}
+}
+
+// Stop은 새 작업을 받지 않고, 대기 중인 작업이 끝날 때까지 기다립니다
+func (w *ThumbnailWorker) Stop() {
+	w.mu.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.jobs)
+	}
+	w.mu.Unlock()
+
+	w.wg.Wait()
+}
+
+func (w *ThumbnailWorker) run() {
+	defer w.wg.Done()
+
+	for job := range w.jobs {
+		if err := w.process(job); err != nil {
+			slog.Error("썸네일 생성 실패", "key", job.key, "error", err)
+		}
+	}
+}
+
+func (w *ThumbnailWorker) process(job thumbnailJob) error {
+	if job.contentType == "image/heic" || job.contentType == "image/heif" {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	data, err := w.storage.Get(ctx, job.key)
+	if err != nil {
+		return err
+	}
+
+	orientation := 1
+	if job.contentType == "image/jpeg" {
+		orientation = utils.ExifOrientation(data)
+	}
+
+	img, _, err := utils.DecodeImage(data)
+	if err != nil {
+		return fmt.Errorf("이미지 디코딩 실패: %v", err)
+	}
+	img = utils.ApplyOrientation(img, orientation)
+
+	for _, width := range utils.ThumbnailWidths {
+		thumbnail := utils.ResizeToWidth(img, width)
+
+		var jpegBuf bytes.Buffer
+		if err := jpeg.Encode(&jpegBuf, flatten(thumbnail), &jpeg.Options{Quality: thumbnailJPEGQuality}); err != nil {
+			return fmt.Errorf("JPEG 인코딩 실패: %v", err)
+		}
+		if err := w.storage.Put(ctx, utils.ThumbnailKey(job.key, width, ".jpg"), "image/jpeg", &jpegBuf, int64(jpegBuf.Len())); err != nil {
+			return err
+		}
+
+		var webpBuf bytes.Buffer
+		if err := nativewebp.Encode(&webpBuf, thumbnail, nil); err != nil {
+			return fmt.Errorf("WebP 인코딩 실패: %v", err)
+		}
+		if err := w.storage.Put(ctx, utils.ThumbnailKey(job.key, width, ".webp"), "image/webp", &webpBuf, int64(webpBuf.Len())); err != nil {
+			return err
+		}
+	}
+
+	return w.uploadRepo.SetThumbnails(ctx, job.key, utils.ThumbnailWidths)
+}
+
+// flatten은 JPEG로 저장할 수 있도록 투명한 부분을 흰색 배경으로 채웁니다
+func flatten(img image.Image) image.Image {
+	bounds := img.Bounds()
+	dst := image.NewRGBA(bounds)
+	draw.Draw(dst, bounds, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, bounds, img, bounds.Min, draw.Over)
+	return dst
+}
@@ -0,0 +1,245 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/utils"
+)
+
+// LocalStorage는 객체를 로컬 디스크에 저장하는 개발용 저장소입니다.
+// 서명된 URL은 Gin 서버의 utils.LocalStoragePath 경로로 발급되며, handler.NewStorageHandler가 이를 처리합니다.
+type LocalStorage struct {
+	root    string
+	baseURL string
+	secret  []byte
+}
+
+func NewLocalStorage(root, baseURL string, secret []byte) (*LocalStorage, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("저장소 디렉터리 생성 실패: %v", err)
+	}
+
+	return &LocalStorage{
+		root:    root,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		secret:  secret,
+	}, nil
+}
+
+// Path는 키에 해당하는 파일 경로입니다. 저장소 밖을 가리키는 키는 거부합니다.
+func (s *LocalStorage) Path(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if key == "" || cleaned != "/"+key {
+		return "", fmt.Errorf("잘못된 객체 키: %s", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(cleaned)), nil
+}
+
+func (s *LocalStorage) sign(method, key string, expires int64, contentType string, size int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "%s\n%s\n%d\n%s\n%d", method, key, expires, contentType, size)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *LocalStorage) signedURL(method, key, contentType string, size int64, expires time.Duration) string {
+	expiresAt := time.Now().Add(expires).Unix()
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expiresAt, 10))
+	if method == "PUT" {
+		query.Set("contentType", contentType)
+		query.Set("size", strconv.FormatInt(size, 10))
+	}
+	query.Set("signature", s.sign(method, key, expiresAt, contentType, size))
+
+	return s.baseURL + utils.LocalStoragePath + key + "?" + query.Encode()
+}
+
+// Verify는 서명된 URL의 쿼리가 method와 key에 대해 유효한지 확인합니다
+func (s *LocalStorage) Verify(method, key string, query url.Values) bool {
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return false
+	}
+
+	var size int64
+	contentType := ""
+	if method == "PUT" {
+		contentType = query.Get("contentType")
+		size, err = strconv.ParseInt(query.Get("size"), 10, 64)
+		if err != nil {
+			return false
+		}
+	}
+
+	expected := s.sign(method, key, expires, contentType, size)
+	return hmac.Equal([]byte(expected), []byte(query.Get("signature")))
+}
+
+// Write는 body를 임시 파일에 쓴 뒤 키 위치로 옮깁니다. 쓰다가 실패해도 기존 객체는 남습니다.
+func (s *LocalStorage) Write(key string, body io.Reader) error {
+	target, err := s.Path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("객체 저장 실패: %v", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("객체 저장 실패: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("객체 저장 실패: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("객체 저장 실패: %v", err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return fmt.Errorf("객체 저장 실패: %v", err)
+	}
+	return nil
+}
+
+func (s *LocalStorage) PresignPut(ctx context.Context, key, contentType string, size int64, expires time.Duration) (string, error) {
+	if _, err := s.Path(key); err != nil {
+		return "", err
+	}
+	return s.signedURL("PUT", key, contentType, size, expires), nil
+}
+
+func (s *LocalStorage) PresignGet(ctx context.Context, key string, expires time.Duration) (string, error) {
+	if _, err := s.Path(key); err != nil {
+		return "", err
+	}
+	return s.signedURL("GET", key, "", 0, expires), nil
+}
+
+func (s *LocalStorage) Head(ctx context.Context, key string) (*domain.ObjectInfo, error) {
+	target, err := s.Path(key)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(target)
+	if err != nil {
+		return nil, fmt.Errorf("객체 조회 실패: %v", err)
+	}
+
+	return &domain.ObjectInfo{
+		Key:          key,
+		Size:         info.Size(),
+		ContentType:  mime.TypeByExtension(path.Ext(key)),
+		LastModified: info.ModTime(),
+	}, nil
+}
+
+func (s *LocalStorage) ReadPrefix(ctx context.Context, key string, n int64) ([]byte, error) {
+	target, err := s.Path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(target)
+	if err != nil {
+		return nil, fmt.Errorf("객체 읽기 실패: %v", err)
+	}
+	defer file.Close()
+
+	return io.ReadAll(io.LimitReader(file, n))
+}
+
+func (s *LocalStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	target, err := s.Path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(target)
+	if err != nil {
+		return nil, fmt.Errorf("객체 읽기 실패: %v", err)
+	}
+	return data, nil
+}
+
+func (s *LocalStorage) Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error {
+	return s.Write(key, io.LimitReader(body, size))
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	target, err := s.Path(key)
+	if err != nil {
+		return err
+	}
+	// S3와 같이 없는 객체를 지워도 오류로 보지 않습니다
+	if err := os.Remove(target); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("객체 삭제 실패: %v", err)
+	}
+	return nil
+}
+
+func (s *LocalStorage) Ping(ctx context.Context) error {
+	info, err := os.Stat(s.root)
+	if err != nil {
+		return fmt.Errorf("저장소 디렉터리 확인 실패: %v", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("저장소 경로가 디렉터리가 아닙니다: %s", s.root)
+	}
+	return nil
+}
+
+func (s *LocalStorage) List(ctx context.Context, prefix string) ([]*domain.ObjectInfo, error) {
+	var objects []*domain.ObjectInfo
+
+	err := filepath.WalkDir(s.root, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".upload-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.root, file)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, &domain.ObjectInfo{
+			Key:          key,
+			Size:         info.Size(),
+			ContentType:  mime.TypeByExtension(path.Ext(key)),
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("객체 목록 조회 실패: %v", err)
+	}
+
+	return objects, nil
+}
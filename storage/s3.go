@@ -0,0 +1,171 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/doyeon0307/tickit-backend/domain"
+)
+
+type s3Storage struct {
+	client *s3.Client
+	bucket string
+}
+
+func NewS3Storage(accessKey, secretKey, region, bucket string) (domain.ObjectStorage, error) {
+	creds := credentials.NewStaticCredentialsProvider(accessKey, secretKey, "")
+
+	cfg, err := config.LoadDefaultConfig(context.TODO(), config.WithCredentialsProvider(creds), config.WithRegion(region))
+
+	if err != nil {
+		return nil, fmt.Errorf("AWS Config 실패: %v", err)
+	}
+
+	client := s3.NewFromConfig(cfg)
+
+	return &s3Storage{
+		client: client,
+		bucket: bucket,
+	}, nil
+}
+
+// PresignPut은 Content-Type과 Content-Length가 서명에 포함된 업로드 URL을 생성합니다.
+// 클라이언트는 같은 값의 헤더로 PUT 요청을 보내야 합니다.
+func (s *s3Storage) PresignPut(ctx context.Context, key, contentType string, size int64, expires time.Duration) (string, error) {
+	presignClient := s3.NewPresignClient(s.client)
+
+	presignReq, err := presignClient.PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket:        &s.bucket,
+		Key:           &key,
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(size),
+	}, s3.WithPresignExpires(expires))
+
+	if err != nil {
+		return "", fmt.Errorf("URL 생성 실패: %v", err)
+	}
+
+	return presignReq.URL, nil
+}
+
+// PresignGet은 비공개 버킷의 객체를 expires 동안 읽을 수 있는 URL을 생성합니다
+func (s *s3Storage) PresignGet(ctx context.Context, key string, expires time.Duration) (string, error) {
+	presignClient := s3.NewPresignClient(s.client)
+
+	presignReq, err := presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: &s.bucket,
+		Key:    &key,
+	}, s3.WithPresignExpires(expires))
+
+	if err != nil {
+		return "", fmt.Errorf("URL 생성 실패: %v", err)
+	}
+
+	return presignReq.URL, nil
+}
+
+func (s *s3Storage) Head(ctx context.Context, key string) (*domain.ObjectInfo, error) {
+	output, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: &s.bucket,
+		Key:    &key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("객체 조회 실패: %v", err)
+	}
+
+	return &domain.ObjectInfo{
+		Key:          key,
+		Size:         aws.ToInt64(output.ContentLength),
+		ContentType:  aws.ToString(output.ContentType),
+		LastModified: aws.ToTime(output.LastModified),
+	}, nil
+}
+
+func (s *s3Storage) ReadPrefix(ctx context.Context, key string, n int64) ([]byte, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &s.bucket,
+		Key:    &key,
+		Range:  aws.String(fmt.Sprintf("bytes=0-%d", n-1)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("객체 읽기 실패: %v", err)
+	}
+	defer output.Body.Close()
+
+	return io.ReadAll(io.LimitReader(output.Body, n))
+}
+
+func (s *s3Storage) Get(ctx context.Context, key string) ([]byte, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &s.bucket,
+		Key:    &key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("객체 읽기 실패: %v", err)
+	}
+	defer output.Body.Close()
+
+	return io.ReadAll(output.Body)
+}
+
+func (s *s3Storage) Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        &s.bucket,
+		Key:           &key,
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(size),
+		Body:          body,
+	})
+	if err != nil {
+		return fmt.Errorf("객체 저장 실패: %v", err)
+	}
+	return nil
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: &s.bucket,
+		Key:    &key,
+	})
+	if err != nil {
+		return fmt.Errorf("객체 삭제 실패: %v", err)
+	}
+	return nil
+}
+
+func (s *s3Storage) Ping(ctx context.Context) error {
+	if _, err := s.client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: &s.bucket}); err != nil {
+		return fmt.Errorf("버킷 확인 실패: %v", err)
+	}
+	return nil
+}
+
+func (s *s3Storage) List(ctx context.Context, prefix string) ([]*domain.ObjectInfo, error) {
+	var objects []*domain.ObjectInfo
+
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: &s.bucket,
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("객체 목록 조회 실패: %v", err)
+		}
+		for _, object := range page.Contents {
+			objects = append(objects, &domain.ObjectInfo{
+				Key:          aws.ToString(object.Key),
+				Size:         aws.ToInt64(object.Size),
+				LastModified: aws.ToTime(object.LastModified),
+			})
+		}
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+const ServiceName = "tickit-backend"
+
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterNone   = "none"
+)
+
+// Setup은 전역 TracerProvider를 설정하고, 종료할 때 남은 스팬을 내보내는 함수를 반환합니다.
+// exporter가 none이면 스팬을 만들지 않지만, 요청에 담겨 온 traceparent는 그대로 이어받습니다.
+// endpoint는 OTLP 수집기의 host:port이며, 비어 있으면 OTEL_EXPORTER_OTLP_* 환경 변수를 따릅니다.
+func Setup(ctx context.Context, exporter, endpoint string, sampleRatio float64) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var spanExporter sdktrace.SpanExporter
+	switch exporter {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		options := []otlptracehttp.Option{}
+		if endpoint != "" {
+			options = append(options, otlptracehttp.WithEndpoint(endpoint), otlptracehttp.WithInsecure())
+		}
+		otlpExporter, err := otlptracehttp.New(ctx, options...)
+		if err != nil {
+			return nil, err
+		}
+		spanExporter = otlpExporter
+	case ExporterStdout:
+		stdoutExporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stderr))
+		if err != nil {
+			return nil, err
+		}
+		spanExporter = stdoutExporter
+	default:
+		return nil, fmt.Errorf("지원하지 않는 트레이스 내보내기 방식입니다: %s", exporter)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(ServiceName),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
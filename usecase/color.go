@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"github.com/doyeon0307/tickit-backend/dto"
+	"github.com/doyeon0307/tickit-backend/utils"
+)
+
+// paletteSize는 색상 추천 응답에 담는 대표 색 개수입니다
+const paletteSize = 6
+
+var (
+	black = color.NRGBA{A: 255}
+	white = color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+)
+
+func roundRatio(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// suggestColors는 가장 많이 쓰인 색을 배경색으로, 그 위에서 WCAG AA 대비를 만족하는 팔레트 색을 글자색으로 추천합니다.
+// 만족하는 색이 팔레트에 없으면 검은색과 흰색 중 대비가 큰 색을 사용합니다.
+func suggestColors(palette []utils.PaletteColor) *dto.ColorSuggestionDTO {
+	colors := make([]dto.PaletteColorDTO, len(palette))
+	for i, p := range palette {
+		colors[i] = dto.PaletteColorDTO{
+			Color: utils.FormatColor(p.Color),
+			Ratio: roundRatio(p.Weight),
+		}
+	}
+
+	background := palette[0].Color
+	foreground, best := black, 0.0
+	for _, p := range palette[1:] {
+		if ratio := utils.ContrastRatio(background, p.Color); ratio > best {
+			foreground, best = p.Color, ratio
+		}
+	}
+	if best < utils.MinContrastRatio {
+		foreground = black
+		if utils.ContrastRatio(background, white) > utils.ContrastRatio(background, black) {
+			foreground = white
+		}
+	}
+
+	return &dto.ColorSuggestionDTO{
+		Palette:         colors,
+		BackgroundColor: utils.FormatColor(background),
+		ForegroundColor: utils.FormatColor(foreground),
+		ContrastRatio:   roundRatio(utils.ContrastRatio(background, foreground)),
+	}
+}
+
+// validateTicketColors는 티켓 색상 형식을 확인하고, minContrast가 0보다 크면 두 색의 명도 대비도 확인합니다.
+// 비어 있는 색상은 검사하지 않습니다.
+func validateTicketColors(background, foreground string, minContrast float64) error {
+	parse := func(field, key, value string) (color.NRGBA, error) {
+		c, err := utils.ParseColor(value)
+		if err != nil {
+			return c, common.FieldValidationError(field, "format", key)
+		}
+		return c, nil
+	}
+
+	var bg, fg color.NRGBA
+	var err error
+	if background != "" {
+		if bg, err = parse("backgroundColor", "ticket.background_color_format", background); err != nil {
+			return err
+		}
+	}
+	if foreground != "" {
+		if fg, err = parse("foregroundColor", "ticket.foreground_color_format", foreground); err != nil {
+			return err
+		}
+	}
+
+	if minContrast <= 0 || background == "" || foreground == "" {
+		return nil
+	}
+	if ratio := utils.ContrastRatio(bg, fg); ratio < minContrast {
+		return common.FieldValidationError("foregroundColor", "contrast", "ticket.color_contrast", ratio, minContrast)
+	}
+	return nil
+}
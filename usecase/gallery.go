@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"github.com/doyeon0307/tickit-backend/dto"
+	"github.com/doyeon0307/tickit-backend/models"
+	"github.com/doyeon0307/tickit-backend/utils"
+	"github.com/google/uuid"
+)
+
+// galleryEdit는 사진 목록과 대표 사진 아이디를 받아 바뀐 목록과 대표 사진 아이디를 반환합니다
+type galleryEdit func(images []models.Image, coverId string) ([]models.Image, string, error)
+
+func galleryFullError() error {
+	return &common.AppError{
+		Code: common.ErrBadRequest,
+		Key:  "gallery.full",
+		Args: []any{models.MaxGalleryImages},
+	}
+}
+
+func imageNotFoundError() error {
+	return &common.AppError{
+		Code: common.ErrNotFound,
+		Key:  "gallery.image_not_found",
+	}
+}
+
+func imageIndex(images []models.Image, imageId string) int {
+	for i, img := range images {
+		if img.Id == imageId {
+			return i
+		}
+	}
+	return -1
+}
+
+// newGallery는 생성 요청으로 사진 목록을 만듭니다.
+// images가 없으면 기존 클라이언트처럼 image 하나를 대표 사진으로 등록하고,
+// images가 있으면 cover로 지정된 사진, image와 같은 사진, 첫 번째 사진 순으로 대표 사진을 정합니다.
+func newGallery(owns imageOwnerCheck, image string, inputs []dto.ImageInputDTO) ([]models.Image, string, error) {
+	if len(inputs) == 0 {
+		if image == "" {
+			return []models.Image{}, "", nil
+		}
+		inputs = []dto.ImageInputDTO{{Key: image, Cover: true}}
+	}
+	if len(inputs) > models.MaxGalleryImages {
+		return nil, "", galleryFullError()
+	}
+
+	images := make([]models.Image, len(inputs))
+	coverId, matchedId := "", ""
+	for i, input := range inputs {
+		if err := owns(input.Key); err != nil {
+			return nil, "", err
+		}
+		images[i] = models.Image{
+			Id:      uuid.New().String(),
+			Key:     utils.ImageKey(input.Key),
+			Caption: input.Caption,
+		}
+		if input.Cover && coverId == "" {
+			coverId = images[i].Id
+		}
+		if image != "" && images[i].Key == utils.ImageKey(image) && matchedId == "" {
+			matchedId = images[i].Id
+		}
+	}
+	if coverId == "" {
+		coverId = matchedId
+	}
+
+	images, coverId = models.Gallery(images, coverId, "")
+	return images, coverId, nil
+}
+
+// replaceCover는 수정 요청의 image를 기존 클라이언트의 단일 이미지 수정으로 해석합니다.
+// 목록에 있는 사진이면 대표 사진으로 지정하고, 새 사진이면 대표 사진을 교체하며, 빈 값이면 대표 사진을 지웁니다.
+func replaceCover(owns imageOwnerCheck, image string) galleryEdit {
+	return func(images []models.Image, coverId string) ([]models.Image, string, error) {
+		key := utils.ImageKey(image)
+		if key == models.CoverKey(images, coverId) {
+			return images, coverId, nil
+		}
+		if key == "" {
+			return removeImage(coverId)(images, coverId)
+		}
+
+		for _, img := range images {
+			if img.Key == key {
+				return images, img.Id, nil
+			}
+		}
+
+		if err := owns(image); err != nil {
+			return nil, "", err
+		}
+		replaced := models.Image{
+			Id:  uuid.New().String(),
+			Key: key,
+		}
+
+		index := imageIndex(images, coverId)
+		if index < 0 {
+			return addImage(owns, &dto.ImageInputDTO{Key: key, Cover: true})(images, coverId)
+		}
+		edited := append([]models.Image{}, images...)
+		edited[index] = replaced
+		return edited, replaced.Id, nil
+	}
+}
+
+func addImage(owns imageOwnerCheck, input *dto.ImageInputDTO) galleryEdit {
+	return func(images []models.Image, coverId string) ([]models.Image, string, error) {
+		if len(images) >= models.MaxGalleryImages {
+			return nil, "", galleryFullError()
+		}
+		if err := owns(input.Key); err != nil {
+			return nil, "", err
+		}
+
+		added := models.Image{
+			Id:      uuid.New().String(),
+			Key:     utils.ImageKey(input.Key),
+			Caption: input.Caption,
+		}
+		if input.Cover || coverId == "" {
+			coverId = added.Id
+		}
+		return append(append([]models.Image{}, images...), added), coverId, nil
+	}
+}
+
+func updateImage(imageId string, input *dto.ImageUpdateDTO) galleryEdit {
+	return func(images []models.Image, coverId string) ([]models.Image, string, error) {
+		index := imageIndex(images, imageId)
+		if index < 0 {
+			return nil, "", imageNotFoundError()
+		}
+
+		edited := append([]models.Image{}, images...)
+		if input.Caption != nil {
+			edited[index].Caption = *input.Caption
+		}
+		if input.Cover {
+			coverId = imageId
+		}
+		return edited, coverId, nil
+	}
+}
+
+// removeImage는 사진을 지웁니다. 대표 사진을 지우면 남은 사진 중 첫 번째가 대표 사진이 됩니다.
+func removeImage(imageId string) galleryEdit {
+	return func(images []models.Image, coverId string) ([]models.Image, string, error) {
+		index := imageIndex(images, imageId)
+		if index < 0 {
+			return nil, "", imageNotFoundError()
+		}
+
+		edited := append(append([]models.Image{}, images[:index]...), images[index+1:]...)
+		if len(edited) == 0 {
+			return edited, "", nil
+		}
+		edited, coverId = models.Gallery(edited, coverId, "")
+		return edited, coverId, nil
+	}
+}
+
+// reorderImages는 imageIds 순서로 사진을 정렬합니다. imageIds에는 모든 사진의 아이디가 한 번씩 있어야 합니다.
+func reorderImages(imageIds []string) galleryEdit {
+	return func(images []models.Image, coverId string) ([]models.Image, string, error) {
+		if len(imageIds) != len(images) {
+			return nil, "", &common.AppError{
+				Code: common.ErrBadRequest,
+				Key:  "gallery.order_invalid",
+			}
+		}
+
+		edited := make([]models.Image, 0, len(images))
+		seen := make(map[string]bool)
+		for _, imageId := range imageIds {
+			index := imageIndex(images, imageId)
+			if index < 0 || seen[imageId] {
+				return nil, "", &common.AppError{
+					Code: common.ErrBadRequest,
+					Key:  "gallery.order_invalid",
+				}
+			}
+			seen[imageId] = true
+			edited = append(edited, images[index])
+		}
+		return edited, coverId, nil
+	}
+}
+
+// galleryDTOs는 사진마다 서명된 URL을 붙여 응답으로 바꿉니다
+func galleryDTOs(ctx context.Context, signer *ImageURLSigner, images []models.Image, coverId string) []dto.ImageDTO {
+	result := make([]dto.ImageDTO, len(images))
+	for i, img := range images {
+		result[i] = dto.ImageDTO{
+			Id:       img.Id,
+			Image:    signer.URL(ctx, img.Key),
+			ImageKey: img.Key,
+			Caption:  img.Caption,
+			Cover:    img.Id == coverId,
+		}
+	}
+	return result
+}
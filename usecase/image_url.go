@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/metrics"
+	"github.com/doyeon0307/tickit-backend/utils"
+)
+
+const (
+	imageURLExpiry = time.Hour
+	// 남은 유효 시간이 imageURLRefresh보다 짧으면 새로 서명합니다
+	imageURLRefresh = 10 * time.Minute
+
+	// 목록 화면별 썸네일 크기입니다. utils.ThumbnailWidths에 포함되어야 합니다.
+	ticketPreviewWidth   = 640
+	calendarPreviewWidth = 320
+)
+
+type signedImageURL struct {
+	url       string
+	expiresAt time.Time
+}
+
+// ImageURLSigner는 비공개 버킷의 이미지 키를 짧은 유효 기간의 GET URL로 바꿉니다.
+// 목록 조회에서 같은 키를 여러 번 서명하지 않도록 서명된 URL을 프로세스 안에 캐시합니다.
+type ImageURLSigner struct {
+	storage    domain.ObjectStorage
+	uploadRepo domain.UploadRepository
+
+	mu        sync.Mutex
+	cache     map[string]signedImageURL
+	lastSweep time.Time
+}
+
+func NewImageURLSigner(storage domain.ObjectStorage, uploadRepo domain.UploadRepository) *ImageURLSigner {
+	return &ImageURLSigner{
+		storage:    storage,
+		uploadRepo: uploadRepo,
+		cache:      make(map[string]signedImageURL),
+	}
+}
+
+// URL은 저장된 이미지 값(키 또는 기존 URL)의 서명된 GET URL을 반환합니다.
+// 서명에 실패하면 저장된 값을 그대로 반환합니다.
+func (s *ImageURLSigner) URL(ctx context.Context, image string) string {
+	if image == "" {
+		return ""
+	}
+	key := utils.ImageKey(image)
+	// 저장소 밖의 URL은 서명하지 않고 그대로 사용합니다
+	if key == image && strings.Contains(image, "://") {
+		return image
+	}
+	return s.sign(ctx, key, image)
+}
+
+// PreviewImage는 목록에 표시할 썸네일의 서명된 URL입니다
+type PreviewImage struct {
+	JPEG string
+	WebP string
+}
+
+// PreviewURLs는 이미지마다 가로 width 크기 썸네일의 URL을 반환합니다.
+// 썸네일이 아직 없거나 만들 수 없는 이미지는 JPEG에 원본 URL을 담고 WebP는 비워 둡니다.
+func (s *ImageURLSigner) PreviewURLs(ctx context.Context, images []string, width int) []PreviewImage {
+	keys := make([]string, 0, len(images))
+	for _, image := range images {
+		if image != "" {
+			keys = append(keys, utils.ImageKey(image))
+		}
+	}
+
+	ready := make(map[string]bool)
+	if len(keys) > 0 {
+		// 조회에 실패해도 원본으로 목록을 보여줄 수 있으므로 오류는 무시합니다
+		uploads, _ := s.uploadRepo.GetByKeys(ctx, keys)
+		for _, upload := range uploads {
+			if slices.Contains(upload.Thumbnails, width) {
+				ready[upload.Key] = true
+			}
+		}
+	}
+
+	previews := make([]PreviewImage, len(images))
+	for i, image := range images {
+		key := utils.ImageKey(image)
+		if !ready[key] {
+			previews[i] = PreviewImage{JPEG: s.URL(ctx, image)}
+			continue
+		}
+		preview := PreviewImage{
+			JPEG: s.sign(ctx, utils.ThumbnailKey(key, width, ".jpg"), ""),
+			WebP: s.sign(ctx, utils.ThumbnailKey(key, width, ".webp"), ""),
+		}
+		if preview.JPEG == "" {
+			preview = PreviewImage{JPEG: s.URL(ctx, image)}
+		}
+		previews[i] = preview
+	}
+	return previews
+}
+
+// sign은 키의 서명된 URL을 캐시에서 찾거나 새로 만들고, 실패하면 fallback을 반환합니다
+func (s *ImageURLSigner) sign(ctx context.Context, key, fallback string) string {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cached, ok := s.cache[key]; ok && cached.expiresAt.Sub(now) > imageURLRefresh {
+		return cached.url
+	}
+
+	url, err := s.storage.PresignGet(ctx, key, imageURLExpiry)
+	if err != nil {
+		return fallback
+	}
+	metrics.PresignedURLIssued(metrics.PresignDownload)
+
+	s.evictExpired(now)
+	s.cache[key] = signedImageURL{
+		url:       url,
+		expiresAt: now.Add(imageURLExpiry),
+	}
+	return url
+}
+
+// evictExpired는 더 이상 재사용할 수 없는 URL을 캐시에서 지웁니다. 캐시 전체를 훑으므로 1분에 한 번만 수행합니다.
+func (s *ImageURLSigner) evictExpired(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	s.lastSweep = now
+
+	for key, cached := range s.cache {
+		if cached.expiresAt.Sub(now) <= imageURLRefresh {
+			delete(s.cache, key)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"sync"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/i18n"
+)
+
+const (
+	// localeCacheTTL은 사용자 언어를 다시 읽기 전까지 보관하는 시간입니다.
+	// 다른 서버에서 바꾼 언어는 이 시간이 지나야 반영됩니다.
+	localeCacheTTL = time.Minute
+	// localeCacheSize는 보관할 사용자 수의 상한입니다
+	localeCacheSize = 10000
+)
+
+// localeCache는 인증된 요청마다 사용자 문서를 읽지 않도록 사용자가 설정한 언어를 잠시 보관합니다
+type localeCache struct {
+	mu      sync.Mutex
+	entries map[string]localeEntry
+}
+
+type localeEntry struct {
+	locale  i18n.Locale
+	ok      bool
+	expires time.Time
+}
+
+func newLocaleCache() *localeCache {
+	return &localeCache{
+		entries: make(map[string]localeEntry),
+	}
+}
+
+// get은 보관 중인 언어입니다. 마지막 값은 보관 중인지 여부입니다.
+func (c *localeCache) get(userId string, now time.Time) (i18n.Locale, bool, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, found := c.entries[userId]
+	if !found || now.After(entry.expires) {
+		return "", false, false
+	}
+	return entry.locale, entry.ok, true
+}
+
+// set은 언어를 보관합니다. 상한에 이르면 만료된 값을 지우고, 그래도 가득 차 있으면 모두 비웁니다.
+func (c *localeCache) set(userId string, locale i18n.Locale, ok bool, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, found := c.entries[userId]; !found && len(c.entries) >= localeCacheSize {
+		for key, entry := range c.entries {
+			if now.After(entry.expires) {
+				delete(c.entries, key)
+			}
+		}
+		if len(c.entries) >= localeCacheSize {
+			clear(c.entries)
+		}
+	}
+	c.entries[userId] = localeEntry{locale: locale, ok: ok, expires: now.Add(localeCacheTTL)}
+}
+
+func (c *localeCache) delete(userId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, userId)
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/utils"
+)
+
+// userLocation은 티켓·일정에 지정된 시간대가 있으면 그 값을, 없으면 사용자 시간대를 사용합니다
+func userLocation(ctx context.Context, userRepo domain.UserRepository, userId, override string) (*time.Location, string, error) {
+	name := override
+	if name == "" {
+		user, err := userRepo.GetById(ctx, userId)
+		if err != nil {
+			return nil, "", err
+		}
+		name = user.TimeZone
+	}
+	if name == "" {
+		name = utils.DefaultTimeZone
+	}
+
+	loc, err := utils.LoadTimeZone(name)
+	if err != nil {
+		return nil, "", common.FieldValidationError("timeZone", "timezone", "validation.time_zone")
+	}
+	return loc, name, nil
+}
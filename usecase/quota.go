@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/dto"
+	"github.com/doyeon0307/tickit-backend/models"
+)
+
+// StorageQuota는 요금제별 저장 공간을 기준으로 사용자의 업로드를 제한합니다.
+// 사용량은 사용자 문서의 카운터로, 업로드 URL을 발급하거나 직접 업로드할 때 조건부 $inc로 예약하고
+// 확인에 실패하거나 정리 작업으로 지워지면 돌려놓습니다. 동시에 예약해도 합계가 저장 공간을 넘지 않습니다.
+type StorageQuota struct {
+	userRepo   domain.UserRepository
+	uploadRepo domain.UploadRepository
+	quotas     map[models.Plan]int64
+}
+
+func NewStorageQuota(userRepo domain.UserRepository, uploadRepo domain.UploadRepository, quotas map[models.Plan]int64) *StorageQuota {
+	return &StorageQuota{
+		userRepo:   userRepo,
+		uploadRepo: uploadRepo,
+		quotas:     quotas,
+	}
+}
+
+// user는 사용자와 요금제의 저장 공간입니다.
+// 카운터가 생기기 전에 가입한 사용자는 확인을 마친 업로드 기록의 합계로 카운터를 채웁니다.
+func (q *StorageQuota) user(ctx context.Context, userId string) (*models.User, int64, error) {
+	user, err := q.userRepo.GetById(ctx, userId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if user.StorageUsedBytes == nil {
+		used, _, err := q.uploadRepo.GetUsage(ctx, userId)
+		if err != nil {
+			return nil, 0, err
+		}
+		if err := q.userRepo.InitStorageUsage(ctx, userId, used); err != nil {
+			return nil, 0, err
+		}
+		// 다른 요청이 먼저 채웠을 수 있으므로 다시 읽습니다
+		if user, err = q.userRepo.GetById(ctx, userId); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	quota, ok := q.quotas[user.CurrentPlan()]
+	if !ok {
+		quota = q.quotas[models.PlanFree]
+	}
+	return user, quota, nil
+}
+
+func (q *StorageQuota) Usage(ctx context.Context, userId string) (*dto.StorageUsageDTO, error) {
+	user, quota, err := q.user(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, files, err := q.uploadRepo.GetUsage(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	used := *user.StorageUsedBytes
+	return &dto.StorageUsageDTO{
+		Plan:           user.CurrentPlan(),
+		UsedBytes:      used,
+		QuotaBytes:     quota,
+		RemainingBytes: max(quota-used, 0),
+		Files:          files,
+	}, nil
+}
+
+// Reserve는 size 바이트를 사용량에 더합니다. 더하면 저장 공간을 넘는 경우 더하지 않고 ErrQuotaExceeded를 반환합니다.
+func (q *StorageQuota) Reserve(ctx context.Context, userId string, size int64) error {
+	_, quota, err := q.user(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	ok, err := q.userRepo.ReserveStorage(ctx, userId, size, quota)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+
+	// 거절된 시점의 사용량으로 안내합니다
+	user, quota, err := q.user(ctx, userId)
+	if err != nil {
+		return err
+	}
+	return &common.AppError{
+		Code: common.ErrQuotaExceeded,
+		Key:  "upload.quota_exceeded",
+		Args: []any{user.CurrentPlan(), float64(quota) / (1 << 20), float64(*user.StorageUsedBytes) / (1 << 20)},
+	}
+}
+
+// Release는 Reserve로 예약한 size 바이트를 사용량에서 뺍니다. 실패해도 업로드 자체는 처리되었으므로 로그만 남깁니다.
+func (q *StorageQuota) Release(ctx context.Context, userId string, size int64) {
+	if size <= 0 {
+		return
+	}
+	if err := q.userRepo.ReleaseStorage(context.WithoutCancel(ctx), userId, size); err != nil {
+		slog.WarnContext(ctx, "저장 공간 반환 실패", "userId", userId, "size", size, "error", err)
+	}
+}
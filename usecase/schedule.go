@@ -2,24 +2,126 @@ package usecase
 
 import (
 	"context"
+	"log/slog"
+	"time"
 
+	"github.com/doyeon0307/tickit-backend/common"
 	"github.com/doyeon0307/tickit-backend/domain"
 	"github.com/doyeon0307/tickit-backend/dto"
+	"github.com/doyeon0307/tickit-backend/i18n"
 	"github.com/doyeon0307/tickit-backend/models"
+	"github.com/doyeon0307/tickit-backend/utils"
 )
 
+// defaultScheduleDuration은 소요 시간을 입력하지 않은 일정의 충돌 검사에 사용됩니다
+const defaultScheduleDuration = 2 * time.Hour
+
 type scheduleUsecase struct {
 	scheduleRepo domain.ScheduleRepository
+	userRepo     domain.UserRepository
+	uploadRepo   domain.UploadRepository
+	imageSigner  *ImageURLSigner
 }
 
-func NewScheduleUsecase(repo domain.ScheduleRepository) domain.ScheduleUsecase {
+func NewScheduleUsecase(repo domain.ScheduleRepository, userRepo domain.UserRepository, uploadRepo domain.UploadRepository, imageSigner *ImageURLSigner) domain.ScheduleUsecase {
 	return &scheduleUsecase{
 		scheduleRepo: repo,
+		userRepo:     userRepo,
+		uploadRepo:   uploadRepo,
+		imageSigner:  imageSigner,
+	}
+}
+
+func validateStatuses(statuses []models.ScheduleStatus) error {
+	for _, status := range statuses {
+		if !status.IsValid() {
+			return common.FieldValidationError("status", "oneof", "schedule.status_unknown", status)
+		}
+	}
+	return nil
+}
+
+// scheduleInterval은 loc 기준의 날짜와 시작 시간, 종료 시간 또는 소요 시간(분)으로 일정의 시작과 끝을 계산합니다
+func scheduleInterval(date string, startTime, endTime *dto.TimeOfDay, duration int, loc *time.Location) (time.Time, time.Time, int, error) {
+	start, err := utils.CombineDateTime(date, startTime.Hour, startTime.Minute, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, 0, common.FieldValidationError("date", "format", "validation.date_format")
+	}
+
+	if endTime != nil {
+		end := time.Date(start.Year(), start.Month(), start.Day(), endTime.Hour, endTime.Minute, 0, 0, loc)
+		// 종료 시간이 시작 시간보다 이르면 자정을 넘긴 일정으로 봅니다
+		if !end.After(start) {
+			end = end.AddDate(0, 0, 1)
+		}
+		return start, end, int(end.Sub(start).Minutes()), nil
+	}
+
+	if duration < 0 {
+		return time.Time{}, time.Time{}, 0, common.FieldValidationError("duration", "min", "schedule.duration_negative")
+	}
+	if duration == 0 {
+		return start, start.Add(defaultScheduleDuration), 0, nil
+	}
+	return start, start.Add(time.Duration(duration) * time.Minute), duration, nil
+}
+
+// timeOf는 저장된 AM/PM-HH-MM 형식의 시작 시간을 불러옵니다
+func timeOf(model *models.Schedule) *dto.TimeOfDay {
+	t, err := dto.ParseTimeOfDay(model.Time)
+	if err != nil {
+		return nil
+	}
+	return t
+}
+
+// endTimeOf는 소요 시간이 입력된 일정의 종료 시간을 반환합니다
+func endTimeOf(model *models.Schedule) *dto.TimeOfDay {
+	if model.Duration == 0 {
+		return nil
+	}
+	_, hour, minute := utils.SplitDateTime(model.EndAt, utils.StoredLocation(model.TimeZone))
+	return dto.NewTimeOfDay(hour, minute)
+}
+
+func (u scheduleUsecase) conflictWarnings(ctx context.Context, userId, excludeId string, start, end time.Time) ([]dto.ScheduleWarningDTO, error) {
+	overlaps, err := u.scheduleRepo.FindOverlapping(ctx, userId, start, end, excludeId)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings := make([]dto.ScheduleWarningDTO, len(overlaps))
+	for i, overlap := range overlaps {
+		warnings[i] = dto.ScheduleWarningDTO{
+			Code:       "SCHEDULE_CONFLICT",
+			Message:    i18n.T(ctx, "schedule.overlap_warning", overlap.Title),
+			ScheduleId: overlap.Id,
+		}
 	}
+	return warnings, nil
 }
 
-func (u scheduleUsecase) GetSchedulePreviewsForTicket(userId, date string) ([]*dto.ScheduleTicketPreviewDTO, error) {
-	schedules, err := u.scheduleRepo.GetPreviewsForTicket(context.Background(), userId, date)
+func (u scheduleUsecase) GetSchedulePreviewsForTicket(ctx context.Context, userId, date string, statuses []models.ScheduleStatus) ([]*dto.ScheduleTicketPreviewDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.GetSchedulePreviewsForTicket")
+	defer span.End()
+
+	if err := validateStatuses(statuses); err != nil {
+		return nil, err
+	}
+	// 티켓은 관람을 마친 일정으로만 만들 수 있습니다
+	if len(statuses) == 0 {
+		statuses = []models.ScheduleStatus{models.StatusAttended}
+	}
+	// 오늘 날짜는 사용자 시간대 기준으로 계산합니다
+	if date == "" {
+		loc, _, err := userLocation(ctx, u.userRepo, userId, "")
+		if err != nil {
+			return nil, err
+		}
+		date = time.Now().In(loc).Format("2006-01-02")
+	}
+
+	schedules, err := u.scheduleRepo.GetPreviewsForTicket(ctx, userId, date, statuses)
 	if err != nil {
 		return nil, err
 	}
@@ -36,130 +138,499 @@ func (u scheduleUsecase) GetSchedulePreviewsForTicket(userId, date string) ([]*d
 	return previews, nil
 }
 
-func (u scheduleUsecase) GetSchedulePreviewsForCalendar(userId, startDate, endDate string) ([]*dto.ScheduleCalendarPreviewDTO, error) {
-	schedules, err := u.scheduleRepo.GetPreviewsForCalendar(context.Background(), userId, startDate, endDate)
+func (u scheduleUsecase) GetSchedulePreviewsForCalendar(ctx context.Context, userId, startDate, endDate string, statuses []models.ScheduleStatus) ([]*dto.ScheduleCalendarPreviewDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.GetSchedulePreviewsForCalendar")
+	defer span.End()
+
+	if err := validateStatuses(statuses); err != nil {
+		return nil, err
+	}
+
+	schedules, err := u.scheduleRepo.GetPreviewsForCalendar(ctx, userId, startDate, endDate, statuses)
 	if err != nil {
 		return nil, err
 	}
 
+	images := make([]string, len(schedules))
+	for i, schedule := range schedules {
+		images[i] = schedule.Image
+	}
+	thumbnails := u.imageSigner.PreviewURLs(ctx, images, calendarPreviewWidth)
+
 	previews := make([]*dto.ScheduleCalendarPreviewDTO, len(schedules))
 	for i, schedule := range schedules {
 		previews[i] = &dto.ScheduleCalendarPreviewDTO{
-			Id:    schedule.Id,
-			Title: schedule.Title,
-			Image: schedule.Image,
-			Date:  schedule.Date,
+			Id:        schedule.Id,
+			Title:     schedule.Title,
+			Image:     thumbnails[i].JPEG,
+			ImageWebp: thumbnails[i].WebP,
+			ImageKey:  utils.ImageKey(schedule.Image),
+			Date:      schedule.Date,
+			Status:    schedule.CurrentStatus(),
 		}
 	}
 
 	return previews, nil
 }
 
-func (u scheduleUsecase) GetScheduleById(userId, id string) (*dto.ScheduleResponseDTO, error) {
-	model, err := u.scheduleRepo.GetById(context.Background(), userId, id)
+func (u scheduleUsecase) GetScheduleById(ctx context.Context, userId, id string) (*dto.ScheduleResponseDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.GetScheduleById")
+	defer span.End()
+
+	model, err := u.scheduleRepo.GetById(ctx, userId, id)
 	if err != nil {
 		return nil, err
 	}
 
+	images, coverId := model.Gallery()
+
 	schedule := &dto.ScheduleResponseDTO{
-		Id:        model.Id,
-		Date:      model.Date,
-		Title:     model.Title,
-		Number:    model.Number,
-		Image:     model.Image,
-		Thumbnail: model.Thumbnail,
-		Location:  model.Location,
-		Time:      model.Time,
-		Seat:      model.Seat,
-		Casting:   model.Casting,
-		Company:   model.Company,
-		Link:      model.Link,
-		Memo:      model.Memo,
+		Id:            model.Id,
+		Date:          model.Date,
+		Title:         model.Title,
+		Number:        model.Number,
+		Image:         u.imageSigner.URL(ctx, model.Image),
+		ImageKey:      utils.ImageKey(model.Image),
+		Images:        galleryDTOs(ctx, u.imageSigner, images, coverId),
+		Thumbnail:     model.Thumbnail,
+		Location:      model.Location,
+		Time:          timeOf(model),
+		Duration:      model.Duration,
+		EndTime:       endTimeOf(model),
+		TimeZone:      model.TimeZone,
+		Seat:          model.Seat,
+		Casting:       model.Casting,
+		Company:       model.Company,
+		Link:          model.Link,
+		Memo:          model.Memo,
+		Status:        model.CurrentStatus(),
+		StatusHistory: model.StatusHistory,
+		TicketId:      model.TicketId,
+		Lottery:       model.Lottery,
 	}
 
 	return schedule, nil
 }
 
-func (u scheduleUsecase) CreateSchedule(userId string, schedule *dto.ScheduleDTO) (*dto.ScheduleResponseDTO, error) {
+func (u scheduleUsecase) CreateSchedule(ctx context.Context, userId string, schedule *dto.ScheduleDTO) (*dto.ScheduleResponseDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.CreateSchedule")
+	defer span.End()
+
+	images, coverId, err := newGallery(ownedImages(ctx, u.uploadRepo, userId), schedule.Image, schedule.Images)
+	if err != nil {
+		return nil, err
+	}
+
+	status := schedule.Status
+	if status == "" {
+		status = models.StatusPlanned
+	}
+	if !status.IsValid() {
+		return nil, common.FieldValidationError("status", "oneof", "schedule.status_unknown", status)
+	}
+	history := []models.StatusChange{
+		{
+			Status:    status,
+			ChangedAt: time.Now(),
+		},
+	}
+
+	loc, timeZone, err := userLocation(ctx, u.userRepo, userId, schedule.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, duration, err := scheduleInterval(schedule.Date, schedule.Time, schedule.EndTime, schedule.Duration, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []dto.ScheduleWarningDTO
+	if status.IsActive() {
+		warnings, err = u.conflictWarnings(ctx, userId, "", start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	model := &models.Schedule{
-		UserId:    userId,
-		Date:      schedule.Date,
-		Title:     schedule.Title,
-		Number:    schedule.Number,
-		Image:     schedule.Image,
-		Thumbnail: schedule.Thumbnail,
-		Location:  schedule.Location,
-		Time:      schedule.Time,
-		Seat:      schedule.Seat,
-		Casting:   schedule.Casting,
-		Company:   schedule.Company,
-		Link:      schedule.Link,
-		Memo:      schedule.Memo,
-	}
-
-	id, err := u.scheduleRepo.Create(context.Background(), model)
+		UserId:        userId,
+		Date:          schedule.Date,
+		Title:         schedule.Title,
+		Number:        schedule.Number,
+		Image:         models.CoverKey(images, coverId),
+		Images:        images,
+		CoverImageId:  coverId,
+		Thumbnail:     schedule.Thumbnail,
+		Location:      schedule.Location,
+		Time:          schedule.Time.String(),
+		StartAt:       start,
+		EndAt:         end,
+		Duration:      duration,
+		TimeZone:      timeZone,
+		Seat:          schedule.Seat,
+		Casting:       schedule.Casting,
+		Company:       schedule.Company,
+		Link:          schedule.Link,
+		Memo:          schedule.Memo,
+		Status:        status,
+		StatusHistory: history,
+	}
+
+	id, err := u.scheduleRepo.Create(ctx, model)
 	if err != nil {
 		return &dto.ScheduleResponseDTO{}, err
 	}
 
 	result := &dto.ScheduleResponseDTO{
-		Id:        id,
-		Date:      schedule.Date,
-		Title:     schedule.Title,
-		Number:    schedule.Number,
-		Image:     schedule.Image,
-		Thumbnail: schedule.Thumbnail,
-		Location:  schedule.Location,
-		Time:      schedule.Time,
-		Seat:      schedule.Seat,
-		Casting:   schedule.Casting,
-		Company:   schedule.Company,
-		Link:      schedule.Link,
-		Memo:      schedule.Memo,
+		Id:            id,
+		Date:          schedule.Date,
+		Title:         schedule.Title,
+		Number:        schedule.Number,
+		Image:         u.imageSigner.URL(ctx, model.Image),
+		ImageKey:      model.Image,
+		Images:        galleryDTOs(ctx, u.imageSigner, images, coverId),
+		Thumbnail:     schedule.Thumbnail,
+		Location:      schedule.Location,
+		Time:          schedule.Time,
+		Duration:      model.Duration,
+		EndTime:       endTimeOf(model),
+		TimeZone:      timeZone,
+		Seat:          schedule.Seat,
+		Casting:       schedule.Casting,
+		Company:       schedule.Company,
+		Link:          schedule.Link,
+		Memo:          schedule.Memo,
+		Status:        status,
+		StatusHistory: history,
+		Warnings:      warnings,
 	}
 	return result, nil
 }
 
-func (u scheduleUsecase) UpdateSchedule(userId, id string, schedule *dto.ScheduleResponseDTO) (*dto.ScheduleResponseDTO, error) {
+func (u scheduleUsecase) UpdateSchedule(ctx context.Context, userId, id string, schedule *dto.ScheduleResponseDTO) (*dto.ScheduleResponseDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.UpdateSchedule")
+	defer span.End()
+
+	current, err := u.scheduleRepo.GetById(ctx, userId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 사진 목록은 /images 경로로 수정하며, image는 기존 클라이언트를 위해 대표 사진 교체로 처리합니다
+	images, coverId, err := replaceCover(ownedImages(ctx, u.uploadRepo, userId), schedule.Image)(current.Gallery())
+	if err != nil {
+		return nil, err
+	}
+
+	override := schedule.TimeZone
+	if override == "" {
+		override = current.TimeZone
+	}
+	loc, timeZone, err := userLocation(ctx, u.userRepo, userId, override)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, duration, err := scheduleInterval(schedule.Date, schedule.Time, schedule.EndTime, schedule.Duration, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []dto.ScheduleWarningDTO
+	if current.CurrentStatus().IsActive() {
+		warnings, err = u.conflictWarnings(ctx, userId, id, start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	model := &models.Schedule{
-		UserId:    userId,
-		Date:      schedule.Date,
-		Title:     schedule.Title,
-		Number:    schedule.Number,
-		Image:     schedule.Image,
-		Thumbnail: schedule.Thumbnail,
-		Location:  schedule.Location,
-		Time:      schedule.Time,
-		Seat:      schedule.Seat,
-		Casting:   schedule.Casting,
-		Company:   schedule.Company,
-		Link:      schedule.Link,
-		Memo:      schedule.Memo,
+		UserId:         userId,
+		Date:           schedule.Date,
+		Title:          schedule.Title,
+		Number:         schedule.Number,
+		Image:          models.CoverKey(images, coverId),
+		Images:         images,
+		CoverImageId:   coverId,
+		Thumbnail:      schedule.Thumbnail,
+		Location:       schedule.Location,
+		Time:           schedule.Time.String(),
+		StartAt:        start,
+		EndAt:          end,
+		Duration:       duration,
+		TimeZone:       timeZone,
+		Seat:           schedule.Seat,
+		Casting:        schedule.Casting,
+		Company:        schedule.Company,
+		Link:           schedule.Link,
+		Memo:           schedule.Memo,
+		GalleryVersion: current.GalleryVersion,
 	}
 
-	err := u.scheduleRepo.Update(context.Background(), userId, id, model)
+	err = u.scheduleRepo.Update(ctx, userId, id, model)
 	if err != nil {
 		return nil, err
 	}
 
-	result := &dto.ScheduleResponseDTO{
-		Id:        id,
-		Date:      schedule.Date,
-		Title:     schedule.Title,
-		Number:    schedule.Number,
-		Image:     schedule.Image,
-		Thumbnail: schedule.Thumbnail,
-		Location:  schedule.Location,
-		Time:      schedule.Time,
-		Seat:      schedule.Seat,
-		Casting:   schedule.Casting,
-		Company:   schedule.Company,
-		Link:      schedule.Link,
-		Memo:      schedule.Memo,
+	// 상태는 수정 대상이 아니므로 저장된 값을 다시 불러옵니다
+	result, err := u.GetScheduleById(ctx, userId, id)
+	if err != nil {
+		return nil, err
 	}
+	result.Warnings = warnings
 	return result, nil
 }
 
-func (u scheduleUsecase) DeleteSchedule(userId, id string) error {
-	return u.scheduleRepo.Delete(context.Background(), userId, id)
+func (u scheduleUsecase) DeleteSchedule(ctx context.Context, userId, id string) error {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.DeleteSchedule")
+	defer span.End()
+
+	return u.scheduleRepo.Delete(ctx, userId, id)
+}
+
+func (u scheduleUsecase) ChangeScheduleStatus(ctx context.Context, userId, id string, status models.ScheduleStatus) (*dto.ScheduleResponseDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.ChangeScheduleStatus")
+	defer span.End()
+
+	if !status.IsValid() {
+		return nil, common.FieldValidationError("status", "oneof", "schedule.status_unknown", status)
+	}
+
+	model, err := u.scheduleRepo.GetById(ctx, userId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	current := model.CurrentStatus()
+	if !model.CanTransitionTo(status, now) {
+		return nil, &common.AppError{
+			Code: common.ErrConflict,
+			Key:  "schedule.status_transition",
+			Args: []any{current, status},
+		}
+	}
+
+	if err := u.scheduleRepo.UpdateStatus(ctx, userId, id, current, status, now); err != nil {
+		return nil, err
+	}
+
+	return u.GetScheduleById(ctx, userId, id)
+}
+
+func (u scheduleUsecase) BackfillIntervals(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.BackfillIntervals")
+	defer span.End()
+
+	schedules, err := u.scheduleRepo.GetWithoutInterval(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	filled := 0
+	for _, schedule := range schedules {
+		startTime := timeOf(schedule)
+		if startTime == nil {
+			slog.WarnContext(ctx, "시작 시간을 읽을 수 없어 일정 시각을 채우지 못했습니다", "scheduleId", schedule.Id, "time", schedule.Time)
+			continue
+		}
+
+		// 시간대 없이 저장된 기존 일정은 StoredLocation에 따라 UTC로 해석합니다
+		start, end, _, err := scheduleInterval(schedule.Date, startTime, nil, schedule.Duration, utils.StoredLocation(schedule.TimeZone))
+		if err != nil {
+			slog.WarnContext(ctx, "날짜를 읽을 수 없어 일정 시각을 채우지 못했습니다", "scheduleId", schedule.Id, "date", schedule.Date)
+			continue
+		}
+
+		if err := u.scheduleRepo.SetInterval(ctx, schedule.Id, start, end); err != nil {
+			return filled, err
+		}
+		filled++
+	}
+	return filled, nil
+}
+
+func (u scheduleUsecase) GetScheduleConflicts(ctx context.Context, userId string) ([]*dto.ScheduleConflictDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.GetScheduleConflicts")
+	defer span.End()
+
+	schedules, err := u.scheduleRepo.GetActiveEndingAfter(ctx, userId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	conflictIds := make(map[string][]string)
+	// 시작 시간 순으로 정렬되어 있으므로 이후 일정의 시작이 현재 일정의 끝보다 늦으면 더 비교하지 않습니다
+	for i, schedule := range schedules {
+		for _, other := range schedules[i+1:] {
+			if !other.StartAt.Before(schedule.EndAt) {
+				break
+			}
+			conflictIds[schedule.Id] = append(conflictIds[schedule.Id], other.Id)
+			conflictIds[other.Id] = append(conflictIds[other.Id], schedule.Id)
+		}
+	}
+
+	conflicts := make([]*dto.ScheduleConflictDTO, 0)
+	for _, schedule := range schedules {
+		ids, ok := conflictIds[schedule.Id]
+		if !ok {
+			continue
+		}
+		conflicts = append(conflicts, &dto.ScheduleConflictDTO{
+			Id:          schedule.Id,
+			Title:       schedule.Title,
+			Date:        schedule.Date,
+			Time:        timeOf(schedule),
+			EndTime:     endTimeOf(schedule),
+			ConflictIds: ids,
+		})
+	}
+
+	return conflicts, nil
+}
+
+func (u scheduleUsecase) RegisterLottery(ctx context.Context, userId, id string, entry *dto.LotteryEntryDTO) (*dto.ScheduleResponseDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.RegisterLottery")
+	defer span.End()
+
+	entryType := entry.Type
+	if entryType == "" {
+		entryType = models.EntryLottery
+	}
+	if entryType != models.EntryLottery && entryType != models.EntryWaitlist {
+		return nil, common.FieldValidationError("type", "oneof", "lottery.type_unknown", entryType)
+	}
+	if _, err := time.Parse("2006-01-02", entry.ResultDate); err != nil {
+		return nil, common.FieldValidationError("resultDate", "format", "validation.result_date_format")
+	}
+
+	model, err := u.scheduleRepo.GetById(ctx, userId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 응모는 아직 예매하지 않은 일정에만 등록할 수 있습니다
+	if model.CurrentStatus() != models.StatusPlanned {
+		return nil, &common.AppError{
+			Code: common.ErrConflict,
+			Key:  "lottery.entry_status",
+			Args: []any{model.CurrentStatus()},
+		}
+	}
+	if model.Lottery != nil && model.Lottery.Outcome == models.LotteryPending {
+		return nil, &common.AppError{
+			Code: common.ErrConflict,
+			Key:  "lottery.already_pending",
+		}
+	}
+
+	lottery := &models.LotteryEntry{
+		Type:       entryType,
+		ResultDate: entry.ResultDate,
+		Outcome:    models.LotteryPending,
+		EnteredAt:  time.Now(),
+	}
+	if err := u.scheduleRepo.SetLottery(ctx, userId, id, lottery); err != nil {
+		return nil, err
+	}
+
+	return u.GetScheduleById(ctx, userId, id)
+}
+
+func (u scheduleUsecase) DecideLottery(ctx context.Context, userId, id string, outcome models.LotteryOutcome) (*dto.ScheduleResponseDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.DecideLottery")
+	defer span.End()
+
+	if outcome != models.LotteryWon && outcome != models.LotteryLost {
+		return nil, common.FieldValidationError("outcome", "oneof", "lottery.outcome_invalid", outcome)
+	}
+
+	model, err := u.scheduleRepo.GetById(ctx, userId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 당첨되면 예매가 완료된 것으로 보고 결과와 함께 일정을 BOOKED 상태로 옮깁니다
+	current := model.CurrentStatus()
+	next := current
+	if outcome == models.LotteryWon && current.CanTransitionTo(models.StatusBooked) {
+		next = models.StatusBooked
+	}
+
+	if err := u.scheduleRepo.DecideLottery(ctx, userId, id, outcome, current, next, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return u.GetScheduleById(ctx, userId, id)
+}
+
+func (u scheduleUsecase) GetPendingLotteries(ctx context.Context, userId string) ([]*dto.LotteryPreviewDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.GetPendingLotteries")
+	defer span.End()
+
+	schedules, err := u.scheduleRepo.GetPendingLotteries(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	previews := make([]*dto.LotteryPreviewDTO, len(schedules))
+	for i, schedule := range schedules {
+		previews[i] = &dto.LotteryPreviewDTO{
+			ScheduleId: schedule.Id,
+			Title:      schedule.Title,
+			Date:       schedule.Date,
+			Type:       schedule.Lottery.Type,
+			ResultDate: schedule.Lottery.ResultDate,
+		}
+	}
+
+	return previews, nil
+}
+
+func (u scheduleUsecase) AddScheduleImage(ctx context.Context, userId, id string, image *dto.ImageInputDTO) ([]dto.ImageDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.AddScheduleImage")
+	defer span.End()
+
+	return u.editGallery(ctx, userId, id, addImage(ownedImages(ctx, u.uploadRepo, userId), image))
+}
+
+func (u scheduleUsecase) UpdateScheduleImage(ctx context.Context, userId, id, imageId string, image *dto.ImageUpdateDTO) ([]dto.ImageDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.UpdateScheduleImage")
+	defer span.End()
+
+	return u.editGallery(ctx, userId, id, updateImage(imageId, image))
+}
+
+func (u scheduleUsecase) RemoveScheduleImage(ctx context.Context, userId, id, imageId string) ([]dto.ImageDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.RemoveScheduleImage")
+	defer span.End()
+
+	return u.editGallery(ctx, userId, id, removeImage(imageId))
+}
+
+func (u scheduleUsecase) ReorderScheduleImages(ctx context.Context, userId, id string, imageIds []string) ([]dto.ImageDTO, error) {
+	ctx, span := tracer.Start(ctx, "ScheduleUsecase.ReorderScheduleImages")
+	defer span.End()
+
+	return u.editGallery(ctx, userId, id, reorderImages(imageIds))
+}
+
+// editGallery는 일정의 사진 목록을 수정합니다. 사진 목록이 없던 일정은 이때 목록 형태로 저장됩니다.
+func (u scheduleUsecase) editGallery(ctx context.Context, userId, id string, edit galleryEdit) ([]dto.ImageDTO, error) {
+	schedule, err := u.scheduleRepo.GetById(ctx, userId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	images, coverId, err := edit(schedule.Gallery())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.scheduleRepo.UpdateImages(ctx, userId, id, images, coverId, schedule.GalleryVersion); err != nil {
+		return nil, err
+	}
+	return galleryDTOs(ctx, u.imageSigner, images, coverId), nil
 }
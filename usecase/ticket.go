@@ -2,63 +2,109 @@ package usecase
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
+	"github.com/doyeon0307/tickit-backend/common"
 	"github.com/doyeon0307/tickit-backend/domain"
 	"github.com/doyeon0307/tickit-backend/dto"
+	"github.com/doyeon0307/tickit-backend/metrics"
 	"github.com/doyeon0307/tickit-backend/models"
 	"github.com/doyeon0307/tickit-backend/utils"
 )
 
 type ticketUsecase struct {
-	ticketRepo domain.TicketRepository
+	ticketRepo   domain.TicketRepository
+	scheduleRepo domain.ScheduleRepository
+	userRepo     domain.UserRepository
+	uploadRepo   domain.UploadRepository
+	imageSigner  *ImageURLSigner
+	minContrast  float64
 }
 
-func NewTicketUseCase(repo domain.TicketRepository) domain.TicketUsecase {
+func NewTicketUseCase(repo domain.TicketRepository, scheduleRepo domain.ScheduleRepository, userRepo domain.UserRepository, uploadRepo domain.UploadRepository, imageSigner *ImageURLSigner, minContrast float64) domain.TicketUsecase {
 	return &ticketUsecase{
-		ticketRepo: repo,
+		ticketRepo:   repo,
+		scheduleRepo: scheduleRepo,
+		userRepo:     userRepo,
+		uploadRepo:   uploadRepo,
+		imageSigner:  imageSigner,
+		minContrast:  minContrast,
 	}
 }
 
-func (u ticketUsecase) GetTicketPreviews(userId string) ([]*dto.TicketPreview, error) {
-	models, err := u.ticketRepo.GetPreviews(context.Background(), userId)
+func (u ticketUsecase) GetTicketPreviews(ctx context.Context, userId string) ([]*dto.TicketPreview, error) {
+	ctx, span := tracer.Start(ctx, "TicketUsecase.GetTicketPreviews")
+	defer span.End()
+
+	models, err := u.ticketRepo.GetPreviews(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
 
+	images := make([]string, len(models))
+	for i, model := range models {
+		images[i] = model.Image
+	}
+	thumbnails := u.imageSigner.PreviewURLs(ctx, images, ticketPreviewWidth)
+
 	previews := make([]*dto.TicketPreview, len(models))
 	for i, model := range models {
 		previews[i] = &dto.TicketPreview{
-			Id:    model.Id,
-			Image: model.Image,
+			Id:        model.Id,
+			Image:     thumbnails[i].JPEG,
+			ImageWebp: thumbnails[i].WebP,
+			ImageKey:  utils.ImageKey(model.Image),
 		}
 	}
 	return previews, nil
 }
 
-func (u ticketUsecase) GetTicketByID(userId, id string) (*dto.TicketResponseDTO, error) {
-	model, err := u.ticketRepo.GetById(context.Background(), userId, id)
+func (u ticketUsecase) GetTicketByID(ctx context.Context, userId, id string) (*dto.TicketResponseDTO, error) {
+	ctx, span := tracer.Start(ctx, "TicketUsecase.GetTicketByID")
+	defer span.End()
+
+	model, err := u.ticketRepo.GetById(ctx, userId, id)
 	if err != nil {
 		return nil, err
 	}
 
-	date, time := utils.SplitDateTime(model.DateTime)
+	date, hour, minute := utils.SplitDateTime(model.DateTime, utils.StoredLocation(model.TimeZone))
+	images, coverId := model.Gallery()
 
 	ticket := &dto.TicketResponseDTO{
 		Id:              model.Id,
-		Image:           model.Image,
+		Image:           u.imageSigner.URL(ctx, model.Image),
+		ImageKey:        utils.ImageKey(model.Image),
+		Images:          galleryDTOs(ctx, u.imageSigner, images, coverId),
 		Title:           model.Title,
 		Location:        model.Location,
 		Date:            date,
-		Time:            time,
+		Time:            dto.NewTimeOfDay(hour, minute),
+		TimeZone:        model.TimeZone,
 		BackgroundColor: model.BackgroundColor,
 		ForegroundColor: model.ForegroundColor,
 		Fields:          model.Fields,
+		ScheduleId:      model.ScheduleId,
 	}
 	return ticket, nil
 }
 
-func (u ticketUsecase) CreateTicket(userId string, ticket *dto.TicketDTO) (string, error) {
+func (u ticketUsecase) CreateTicket(ctx context.Context, userId string, ticket *dto.TicketDTO) (string, error) {
+	ctx, span := tracer.Start(ctx, "TicketUsecase.CreateTicket")
+	defer span.End()
+
+	images, coverId, err := newGallery(ownedImages(ctx, u.uploadRepo, userId), ticket.Image, ticket.Images)
+	if err != nil {
+		return "", err
+	}
+	if len(images) == 0 {
+		return "", &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "ticket.image_required",
+		}
+	}
+
 	fields := make([]models.Field, len(ticket.Fields))
 	for i, f := range ticket.Fields {
 		fields[i] = models.Field{
@@ -67,51 +113,181 @@ func (u ticketUsecase) CreateTicket(userId string, ticket *dto.TicketDTO) (strin
 		}
 	}
 
-	dateTime, err := utils.CombineDateTime(ticket.Date, ticket.Time)
+	if err := validateTicketColors(ticket.BackgroundColor, ticket.ForegroundColor, u.minContrast); err != nil {
+		return "", err
+	}
 
+	loc, timeZone, err := userLocation(ctx, u.userRepo, userId, ticket.TimeZone)
 	if err != nil {
 		return "", err
 	}
 
+	dateTime, err := utils.CombineDateTime(ticket.Date, ticket.Time.Hour, ticket.Time.Minute, loc)
+
+	if err != nil {
+		return "", common.FieldValidationError("date", "format", "validation.date_format")
+	}
+
+	if ticket.ScheduleId != "" {
+		schedule, err := u.scheduleRepo.GetById(ctx, userId, ticket.ScheduleId)
+		if err != nil {
+			return "", err
+		}
+		if schedule.CurrentStatus() != models.StatusAttended {
+			return "", &common.AppError{
+				Code: common.ErrConflict,
+				Key:  "ticket.schedule_not_attended",
+			}
+		}
+		if schedule.TicketId != "" {
+			return "", &common.AppError{
+				Code: common.ErrConflict,
+				Key:  "schedule.already_ticketed",
+			}
+		}
+	}
+
 	model := &models.Ticket{
 		UserId:          userId,
-		Image:           ticket.Image,
+		Image:           models.CoverKey(images, coverId),
+		Images:          images,
+		CoverImageId:    coverId,
 		Title:           ticket.Title,
 		Location:        ticket.Location,
 		DateTime:        dateTime,
+		TimeZone:        timeZone,
 		BackgroundColor: ticket.BackgroundColor,
 		ForegroundColor: ticket.ForegroundColor,
 		Fields:          fields,
+		ScheduleId:      ticket.ScheduleId,
 		CreatedAt:       time.Now(),
 	}
 
-	id, err := u.ticketRepo.Create(context.Background(), userId, model)
+	id, err := u.ticketRepo.Create(ctx, userId, model)
 	if err != nil {
 		return "", err
 	}
+
+	if ticket.ScheduleId != "" {
+		if err := u.scheduleRepo.LinkTicket(ctx, userId, ticket.ScheduleId, id); err != nil {
+			// 다른 요청이 먼저 같은 일정으로 티켓을 만든 경우 생성한 티켓을 되돌립니다
+			if err := u.ticketRepo.Delete(context.WithoutCancel(ctx), userId, id); err != nil {
+				slog.WarnContext(ctx, "티켓 생성 되돌리기 실패", "ticketId", id, "error", err)
+			}
+			return "", err
+		}
+	}
+
+	metrics.TicketCreated()
 	return id, nil
 }
 
-func (u ticketUsecase) UpdateTicket(userId, id string, ticket *dto.TicketUpdateDTO) error {
-	dateTime, err := utils.CombineDateTime(ticket.Date, ticket.Time)
+func (u ticketUsecase) UpdateTicket(ctx context.Context, userId, id string, ticket *dto.TicketUpdateDTO) error {
+	ctx, span := tracer.Start(ctx, "TicketUsecase.UpdateTicket")
+	defer span.End()
 
+	current, err := u.ticketRepo.GetById(ctx, userId, id)
 	if err != nil {
 		return err
 	}
 
+	// 사진 목록은 /images 경로로 수정하며, image는 기존 클라이언트를 위해 대표 사진 교체로 처리합니다
+	images, coverId, err := replaceCover(ownedImages(ctx, u.uploadRepo, userId), ticket.Image)(current.Gallery())
+	if err != nil {
+		return err
+	}
+
+	if err := validateTicketColors(ticket.BackgroundColor, ticket.ForegroundColor, u.minContrast); err != nil {
+		return err
+	}
+
+	override := ticket.TimeZone
+	if override == "" {
+		override = current.TimeZone
+	}
+
+	loc, timeZone, err := userLocation(ctx, u.userRepo, userId, override)
+	if err != nil {
+		return err
+	}
+
+	dateTime, err := utils.CombineDateTime(ticket.Date, ticket.Time.Hour, ticket.Time.Minute, loc)
+
+	if err != nil {
+		return common.FieldValidationError("date", "format", "validation.date_format")
+	}
+
 	model := &models.Ticket{
 		UserId:          userId,
-		Image:           ticket.Image,
+		Image:           models.CoverKey(images, coverId),
+		Images:          images,
+		CoverImageId:    coverId,
 		Title:           ticket.Title,
 		Location:        ticket.Location,
 		DateTime:        dateTime,
+		TimeZone:        timeZone,
 		BackgroundColor: ticket.BackgroundColor,
 		ForegroundColor: ticket.ForegroundColor,
 		Fields:          ticket.Fields,
+		GalleryVersion:  current.GalleryVersion,
+	}
+	return u.ticketRepo.Update(ctx, userId, id, model)
+}
+
+func (u ticketUsecase) DeleteTicket(ctx context.Context, userId, id string) error {
+	ctx, span := tracer.Start(ctx, "TicketUsecase.DeleteTicket")
+	defer span.End()
+
+	if err := u.ticketRepo.Delete(ctx, userId, id); err != nil {
+		return err
 	}
-	return u.ticketRepo.Update(context.Background(), userId, id, model)
+	// 삭제된 티켓과 연결된 일정은 다시 티켓으로 만들 수 있습니다.
+	// 티켓은 이미 지워졌으므로 요청이 끊겨도 연결 해제는 마칩니다.
+	return u.scheduleRepo.UnlinkTicket(context.WithoutCancel(ctx), userId, id)
 }
 
-func (u ticketUsecase) DeleteTicket(id string) error {
-	return u.ticketRepo.Delete(context.Background(), id)
+func (u ticketUsecase) AddTicketImage(ctx context.Context, userId, id string, image *dto.ImageInputDTO) ([]dto.ImageDTO, error) {
+	ctx, span := tracer.Start(ctx, "TicketUsecase.AddTicketImage")
+	defer span.End()
+
+	return u.editGallery(ctx, userId, id, addImage(ownedImages(ctx, u.uploadRepo, userId), image))
+}
+
+func (u ticketUsecase) UpdateTicketImage(ctx context.Context, userId, id, imageId string, image *dto.ImageUpdateDTO) ([]dto.ImageDTO, error) {
+	ctx, span := tracer.Start(ctx, "TicketUsecase.UpdateTicketImage")
+	defer span.End()
+
+	return u.editGallery(ctx, userId, id, updateImage(imageId, image))
+}
+
+func (u ticketUsecase) RemoveTicketImage(ctx context.Context, userId, id, imageId string) ([]dto.ImageDTO, error) {
+	ctx, span := tracer.Start(ctx, "TicketUsecase.RemoveTicketImage")
+	defer span.End()
+
+	return u.editGallery(ctx, userId, id, removeImage(imageId))
+}
+
+func (u ticketUsecase) ReorderTicketImages(ctx context.Context, userId, id string, imageIds []string) ([]dto.ImageDTO, error) {
+	ctx, span := tracer.Start(ctx, "TicketUsecase.ReorderTicketImages")
+	defer span.End()
+
+	return u.editGallery(ctx, userId, id, reorderImages(imageIds))
+}
+
+// editGallery는 티켓의 사진 목록을 수정합니다. 사진 목록이 없던 티켓은 이때 목록 형태로 저장됩니다.
+func (u ticketUsecase) editGallery(ctx context.Context, userId, id string, edit galleryEdit) ([]dto.ImageDTO, error) {
+	ticket, err := u.ticketRepo.GetById(ctx, userId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	images, coverId, err := edit(ticket.Gallery())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.ticketRepo.UpdateImages(ctx, userId, id, images, coverId, ticket.GalleryVersion); err != nil {
+		return nil, err
+	}
+	return galleryDTOs(ctx, u.imageSigner, images, coverId), nil
 }
@@ -0,0 +1,379 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"image/png"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/dto"
+	"github.com/doyeon0307/tickit-backend/i18n"
+	"github.com/doyeon0307/tickit-backend/utils"
+)
+
+const (
+	// ocrTimeout은 사진 한 장의 글자 인식에 허용하는 시간입니다
+	ocrTimeout = 30 * time.Second
+)
+
+// stubLabel은 "라벨: 값" 또는 "라벨 값" 형식의 줄을 찾습니다. 긴 라벨을 먼저 적어야 합니다.
+func stubLabel(labels string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)^(?:` + labels + `)(?:\s*:\s*|\s+|$)(.*)$`)
+}
+
+var (
+	titleLabel = stubLabel(`공연명|작품명|영화명|경기명|제목|TITLE|EVENT`)
+	venueLabel = stubLabel(`관람장소|공연장소|공연장|장소|VENUE|PLACE|LOCATION`)
+	seatLabel  = stubLabel(`좌석번호|좌석정보|좌석|SEAT`)
+
+	// 《제목》처럼 한국 티켓에서 작품명을 감싸는 괄호입니다
+	titleBracketPattern = regexp.MustCompile(`[《〈<「『]\s*([^》〉>」』]{2,}?)\s*[》〉>」』]`)
+	venuePattern        = regexp.MustCompile(`(?i)(아트센터|예술의전당|문화회관|회관|아트홀|콘서트홀|홀|극장|씨어터|시어터|경기장|스타디움|야구장|구장|돔|아레나|공연장|CGV|메가박스|롯데시네마|씨네|센터|HALL|THEAT(?:ER|RE)|ARENA|STADIUM|DOME)`)
+	seatPattern         = regexp.MustCompile(`(?:\d+\s*(?:층|루)\s*)?(?:[A-Za-z0-9가-힣]+\s*(?:구역|관|블럭|블록|게이트)\s*)?(?:[A-Za-z]|\d+)\s*열\s*\d+\s*번?`)
+	seatGradePattern    = regexp.MustCompile(`(?:VIP|[RSA])석`)
+
+	datePattern     = regexp.MustCompile(`(?:^|[^\d])(\d{4}|\d{2})\s*[./년-]\s*(\d{1,2})\s*[./월-]\s*(\d{1,2})`)
+	monthDayPattern = regexp.MustCompile(`(?:^|[^\d])(\d{1,2})\s*월\s*(\d{1,2})\s*일`)
+	timePattern     = regexp.MustCompile(`(?i)(?:^|[^\d:])(?:(오전|오후|\bAM|\bPM)\s*)?(\d{1,2})\s*(:|시)\s*(?:(\d{2})\s*분?)?\s*(AM\b|PM\b)?`)
+
+	// 관람일이 아니라 예매·결제한 날짜가 적힌 줄입니다
+	bookingDateKeywords = regexp.MustCompile(`예매|예약|결제|발권|구매|주문`)
+	showDateKeywords    = regexp.MustCompile(`(?i)일시|관람일|공연일|상영일|경기일|날짜|일자|DATE`)
+	showTimeKeywords    = regexp.MustCompile(`(?i)시간|시작|회차|TIME`)
+	// 공연 시작이 아니라 입장 시각이 적힌 줄입니다
+	entryTimeKeywords = regexp.MustCompile(`입장|오픈|개장|OPEN`)
+	// 제목으로 볼 수 없는 예매처·가격·연락처 등의 줄입니다
+	stubNoisePattern = regexp.MustCompile(`(?i)예매|예약|주문|결제|가격|금액|\d원|₩|\d{2,4}-\d{3,4}-\d{4}|http|www\.|바코드|문의|고객센터|인터파크|티켓링크|yes24|멜론티켓|번호`)
+	letterPattern    = regexp.MustCompile(`[A-Za-z가-힣]`)
+)
+
+type draftField struct {
+	value      string
+	confidence float64
+}
+
+type ticketDraftUsecase struct {
+	storage    domain.ObjectStorage
+	uploadRepo domain.UploadRepository
+	recognizer domain.TextRecognizer
+}
+
+// NewTicketDraftUsecase는 사진으로 티켓 초안을 만드는 유스케이스입니다. recognizer가 nil이면 기능을 사용할 수 없습니다.
+func NewTicketDraftUsecase(storage domain.ObjectStorage, uploadRepo domain.UploadRepository, recognizer domain.TextRecognizer) domain.TicketDraftUsecase {
+	return &ticketDraftUsecase{
+		storage:    storage,
+		uploadRepo: uploadRepo,
+		recognizer: recognizer,
+	}
+}
+
+func (u ticketDraftUsecase) DraftFromImage(ctx context.Context, userId, key string) (*dto.TicketDraftDTO, error) {
+	ctx, span := tracer.Start(ctx, "TicketDraftUsecase.DraftFromImage")
+	defer span.End()
+
+	if u.recognizer == nil {
+		return nil, &common.AppError{
+			Code: common.ErrUnavailable,
+			Key:  "ticket.draft_unavailable",
+		}
+	}
+	if err := checkImageOwner(ctx, u.uploadRepo, userId, key); err != nil {
+		return nil, err
+	}
+	key = utils.ImageKey(key)
+
+	getCtx, cancel := context.WithTimeout(ctx, storageTimeout)
+	data, err := u.storage.Get(getCtx, key)
+	cancel()
+	if err != nil {
+		return nil, &common.AppError{
+			Code: common.ErrNotFound,
+			Key:  "image.not_found",
+			Err:  err,
+		}
+	}
+
+	// 휴대폰으로 찍은 사진은 회전 정보를 반영해야 글자를 인식할 수 있습니다
+	img, format, err := utils.DecodeImage(data)
+	if err != nil {
+		return nil, decodeError(err, "ticket.draft_image_unsupported")
+	}
+	if format == "jpeg" {
+		img = utils.ApplyOrientation(img, utils.ExifOrientation(data))
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, common.ServerError(ctx, "ticket.draft_convert_failed", err)
+	}
+
+	ocrCtx, cancel := context.WithTimeout(ctx, ocrTimeout)
+	defer cancel()
+	lines, err := u.recognizer.Recognize(ocrCtx, buf.Bytes())
+	if err != nil {
+		return nil, common.ServerError(ctx, "ticket.draft_ocr_failed", err)
+	}
+
+	// 인식한 좌석은 요청 언어로 된 항목 이름으로 담습니다
+	draft := parseTicketStub(lines, time.Now(), i18n.T(ctx, "ticket.draft_seat"))
+	draft.Ticket.Image = key
+	return draft, nil
+}
+
+// parseTicketStub은 티켓에서 인식한 글자로 티켓 초안을 만듭니다.
+// 라벨("공연명:", "장소" 등)이 붙은 값을 가장 믿고, 없으면 날짜·시간·좌석 형식과 공연장 이름의 특징으로 추정합니다.
+// 항목별 신뢰도는 추정 방식의 가중치에 그 줄의 인식 신뢰도를 곱한 값입니다. 좌석은 seatSubtitle 항목으로 담습니다.
+func parseTicketStub(recognized []domain.TextLine, now time.Time, seatSubtitle string) *dto.TicketDraftDTO {
+	lines := make([]domain.TextLine, 0, len(recognized))
+	text := make([]string, 0, len(recognized))
+	for _, line := range recognized {
+		value := strings.Join(strings.Fields(strings.ReplaceAll(line.Text, "：", ":")), " ")
+		if value == "" {
+			continue
+		}
+		lines = append(lines, domain.TextLine{Text: value, Confidence: line.Confidence})
+		text = append(text, value)
+	}
+
+	// 라벨이 붙은 줄이 다른 항목의 추정에 쓰이지 않도록 라벨부터 찾습니다
+	used := make(map[int]bool)
+	title, hasTitle := findLabeled(lines, titleLabel, used)
+	venue, hasVenue := findLabeled(lines, venueLabel, used)
+	seat, hasSeat := findLabeled(lines, seatLabel, used)
+
+	date, dateLine := findStubDate(lines, now, used)
+	timeOfDay, timeField := findStubTime(lines, dateLine, used)
+	if !hasSeat {
+		seat = findStubSeat(lines, used)
+	}
+	if !hasVenue {
+		venue = findStubVenue(lines, used)
+	}
+	if !hasTitle {
+		title = findStubTitle(lines, used)
+	}
+
+	fields := []dto.Field{}
+	if seat.value != "" {
+		fields = append(fields, dto.Field{Subtitle: seatSubtitle, Content: seat.value})
+	}
+
+	return &dto.TicketDraftDTO{
+		Ticket: dto.TicketDTO{
+			Images:   []dto.ImageInputDTO{},
+			Title:    title.value,
+			Location: venue.value,
+			Date:     date.value,
+			Time:     timeOfDay,
+			Fields:   fields,
+		},
+		Confidence: dto.TicketDraftConfidenceDTO{
+			Title:    roundRatio(title.confidence),
+			Location: roundRatio(venue.confidence),
+			Date:     roundRatio(date.confidence),
+			Time:     roundRatio(timeField.confidence),
+			Seat:     roundRatio(seat.confidence),
+		},
+		Text: text,
+	}
+}
+
+// findLabeled는 라벨이 붙은 값을 찾습니다. 라벨만 있는 줄이면 다음 줄을 값으로 봅니다.
+func findLabeled(lines []domain.TextLine, label *regexp.Regexp, used map[int]bool) (draftField, bool) {
+	for i, line := range lines {
+		if used[i] {
+			continue
+		}
+		parts := label.FindStringSubmatch(line.Text)
+		if parts == nil {
+			continue
+		}
+		if value := strings.TrimSpace(parts[1]); value != "" {
+			used[i] = true
+			return draftField{value: value, confidence: 0.9 * line.Confidence}, true
+		}
+		if i+1 < len(lines) && !used[i+1] {
+			used[i], used[i+1] = true, true
+			confidence := line.Confidence
+			if lines[i+1].Confidence < confidence {
+				confidence = lines[i+1].Confidence
+			}
+			return draftField{value: lines[i+1].Text, confidence: 0.85 * confidence}, true
+		}
+	}
+	return draftField{}, false
+}
+
+// findStubDate는 관람일을 YYYY-MM-DD 형식으로 찾습니다. 연도가 없으면 올해로 봅니다.
+// 예매일·결제일이 함께 적힌 티켓이 많으므로 그런 줄의 날짜는 가장 나중에 고릅니다.
+func findStubDate(lines []domain.TextLine, now time.Time, used map[int]bool) (draftField, int) {
+	best, bestLine := draftField{}, -1
+	for i, line := range lines {
+		weight := 0.8
+		parts := datePattern.FindStringSubmatch(line.Text)
+		if parts == nil {
+			weight = 0.6
+			if md := monthDayPattern.FindStringSubmatch(line.Text); md != nil {
+				parts = []string{md[0], strconv.Itoa(now.Year()), md[1], md[2]}
+			}
+		}
+		if parts == nil {
+			continue
+		}
+
+		year, _ := strconv.Atoi(parts[1])
+		month, _ := strconv.Atoi(parts[2])
+		day, _ := strconv.Atoi(parts[3])
+		if year < 100 {
+			year += 2000
+		}
+		date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+		if date.Year() != year || int(date.Month()) != month || date.Day() != day {
+			continue
+		}
+
+		switch {
+		case bookingDateKeywords.MatchString(line.Text):
+			weight = 0.3
+		case showDateKeywords.MatchString(line.Text):
+			weight = 0.95
+		}
+		if confidence := weight * line.Confidence; confidence > best.confidence {
+			best = draftField{value: date.Format("2006-01-02"), confidence: confidence}
+			bestLine = i
+		}
+	}
+	if bestLine >= 0 {
+		used[bestLine] = true
+	}
+	return best, bestLine
+}
+
+// findStubTime은 공연 시작 시간을 찾습니다. 관람일과 같은 줄의 시간을 우선하고, 입장 시간은 가장 나중에 고릅니다.
+func findStubTime(lines []domain.TextLine, dateLine int, used map[int]bool) (*dto.TimeOfDay, draftField) {
+	var best *dto.TimeOfDay
+	bestField, bestLine := draftField{}, -1
+	for i, line := range lines {
+		if bookingDateKeywords.MatchString(line.Text) {
+			continue
+		}
+		for _, loc := range timePattern.FindAllStringSubmatchIndex(line.Text, -1) {
+			group := func(n int) string {
+				if loc[2*n] < 0 {
+					return ""
+				}
+				return line.Text[loc[2*n]:loc[2*n+1]]
+			}
+			// "2시간"처럼 시각이 아닌 길이는 건너뜁니다
+			if group(3) == "시" && strings.HasPrefix(line.Text[loc[1]:], "간") {
+				continue
+			}
+			if group(3) == ":" && group(4) == "" {
+				continue
+			}
+
+			hour, _ := strconv.Atoi(group(2))
+			minute, _ := strconv.Atoi(group(4))
+			meridiem := strings.ToUpper(group(1) + group(5))
+			switch {
+			case meridiem == "오후" || meridiem == "PM":
+				if hour < 12 {
+					hour += 12
+				}
+			case meridiem == "오전" || meridiem == "AM":
+				if hour == 12 {
+					hour = 0
+				}
+			}
+			if hour > 23 || minute > 59 {
+				continue
+			}
+
+			weight := 0.7
+			switch {
+			case entryTimeKeywords.MatchString(line.Text):
+				weight = 0.4
+			case showTimeKeywords.MatchString(line.Text):
+				weight = 0.9
+			case i == dateLine:
+				weight = 0.85
+			}
+			if confidence := weight * line.Confidence; confidence > bestField.confidence {
+				best = dto.NewTimeOfDay(hour, minute)
+				bestField = draftField{value: best.String(), confidence: confidence}
+				bestLine = i
+			}
+		}
+	}
+	if bestLine >= 0 {
+		used[bestLine] = true
+	}
+	return best, bestField
+}
+
+// findStubSeat은 "B구역 7열 12번" 같은 좌석 형식, "R석" 같은 등급 순서로 좌석을 찾습니다
+func findStubSeat(lines []domain.TextLine, used map[int]bool) draftField {
+	for i, line := range lines {
+		if seat := seatPattern.FindString(line.Text); seat != "" {
+			used[i] = true
+			value := seat
+			if grade := seatGradePattern.FindString(line.Text); grade != "" && !strings.Contains(seat, grade) {
+				value = grade + " " + seat
+			}
+			return draftField{value: strings.TrimSpace(value), confidence: 0.85 * line.Confidence}
+		}
+	}
+	for i, line := range lines {
+		if grade := seatGradePattern.FindString(line.Text); grade != "" {
+			used[i] = true
+			return draftField{value: grade, confidence: 0.5 * line.Confidence}
+		}
+	}
+	return draftField{}
+}
+
+// findStubVenue는 공연장·극장 이름에 흔한 단어가 들어간 줄에서 장소를 찾습니다.
+// 영화관 티켓처럼 장소와 좌석이 한 줄에 있으면 좌석 부분은 뺍니다.
+func findStubVenue(lines []domain.TextLine, used map[int]bool) draftField {
+	for i, line := range lines {
+		if datePattern.MatchString(line.Text) || stubNoisePattern.MatchString(line.Text) {
+			continue
+		}
+		value := strings.TrimSpace(seatPattern.ReplaceAllString(line.Text, ""))
+		if value == "" || !venuePattern.MatchString(value) {
+			continue
+		}
+		if used[i] && value == line.Text {
+			continue
+		}
+		used[i] = true
+		return draftField{value: value, confidence: 0.7 * line.Confidence}
+	}
+	return draftField{}
+}
+
+// findStubTitle은 《》 같은 괄호, 다른 항목으로 쓰이지 않은 첫 줄 순서로 제목을 찾습니다.
+// 제목은 보통 티켓 위쪽에 가장 크게 적혀 있으므로 위에서부터 찾습니다.
+func findStubTitle(lines []domain.TextLine, used map[int]bool) draftField {
+	for i, line := range lines {
+		if parts := titleBracketPattern.FindStringSubmatch(line.Text); parts != nil {
+			used[i] = true
+			return draftField{value: strings.TrimSpace(parts[1]), confidence: 0.75 * line.Confidence}
+		}
+	}
+	for i, line := range lines {
+		if used[i] || stubNoisePattern.MatchString(line.Text) {
+			continue
+		}
+		if len(letterPattern.FindAllString(line.Text, -1)) < 2 {
+			continue
+		}
+		used[i] = true
+		return draftField{value: line.Text, confidence: 0.5 * line.Confidence}
+	}
+	return draftField{}
+}
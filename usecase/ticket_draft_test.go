@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/dto"
+	"github.com/doyeon0307/tickit-backend/ocr"
+)
+
+func TestParseTicketStub(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		recognizer *ocr.Fake
+		title      string
+		location   string
+		date       string
+		time       string
+		seat       string
+		confidence dto.TicketDraftConfidenceDTO
+	}{
+		{
+			name: "라벨이 붙은 공연 티켓",
+			recognizer: ocr.NewFake(
+				"공연명：뮤지컬 《레미제라블》",
+				"일시: 2024.11.23 (토) 오후 7시 30분",
+				"장소 블루스퀘어   신한카드홀",
+				"좌석: VIP석 1층 B구역 7열 12번",
+				"예매일 2024.10.01 10:00",
+				"예매처 인터파크",
+			),
+			title:      "뮤지컬 《레미제라블》",
+			location:   "블루스퀘어 신한카드홀",
+			date:       "2024-11-23",
+			time:       "PM-07-30",
+			seat:       "VIP석 1층 B구역 7열 12번",
+			confidence: dto.TicketDraftConfidenceDTO{Title: 0.9, Location: 0.9, Date: 0.95, Time: 0.85, Seat: 0.9},
+		},
+		{
+			name:       "라벨만 있는 줄은 다음 줄을 값으로 봅니다",
+			recognizer: ocr.NewFake("공연명", "햄릿", "장소:", "국립극장 해오름극장"),
+			title:      "햄릿",
+			location:   "국립극장 해오름극장",
+			confidence: dto.TicketDraftConfidenceDTO{Title: 0.85, Location: 0.85},
+		},
+		{
+			name: "라벨이 없는 영화 티켓",
+			recognizer: ocr.NewFake(
+				"CGV 용산아이파크몰",
+				"듄: 파트2",
+				"2024-03-01 14:20",
+				"IMAX관 F열 10번",
+				"예매번호 1234-5678",
+			),
+			title:      "듄: 파트2",
+			location:   "CGV 용산아이파크몰",
+			date:       "2024-03-01",
+			time:       "PM-02-20",
+			seat:       "IMAX관 F열 10번",
+			confidence: dto.TicketDraftConfidenceDTO{Title: 0.5, Location: 0.7, Date: 0.8, Time: 0.85, Seat: 0.85},
+		},
+		{
+			name: "연도가 없으면 올해로 보고 입장 시간과 러닝타임은 고르지 않습니다",
+			recognizer: ocr.NewFake(
+				"《햄릿》",
+				"입장 오후 1시 30분",
+				"11월 3일 오후 2시",
+				"러닝타임 2시간",
+				"R석",
+			),
+			title:      "햄릿",
+			date:       "2025-11-03",
+			time:       "PM-02-00",
+			seat:       "R석",
+			confidence: dto.TicketDraftConfidenceDTO{Title: 0.75, Date: 0.6, Time: 0.85, Seat: 0.5},
+		},
+		{
+			name: "신뢰도는 줄의 인식 신뢰도를 곱합니다",
+			recognizer: &ocr.Fake{Lines: []domain.TextLine{
+				{Text: "제목: 오페라의 유령", Confidence: 0.5},
+				{Text: "2024/12/24", Confidence: 0.8},
+			}},
+			title:      "오페라의 유령",
+			date:       "2024-12-24",
+			confidence: dto.TicketDraftConfidenceDTO{Title: 0.45, Date: 0.64},
+		},
+		{
+			name:       "없는 날짜는 고르지 않습니다",
+			recognizer: ocr.NewFake("2024.02.30"),
+		},
+		{
+			name:       "인식한 글자가 없는 경우",
+			recognizer: ocr.NewFake(" ", ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := tt.recognizer.Recognize(context.Background(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			draft := parseTicketStub(lines, now, "좌석")
+			ticket := draft.Ticket
+
+			if ticket.Title != tt.title {
+				t.Errorf("title = %q, want %q", ticket.Title, tt.title)
+			}
+			if ticket.Location != tt.location {
+				t.Errorf("location = %q, want %q", ticket.Location, tt.location)
+			}
+			if ticket.Date != tt.date {
+				t.Errorf("date = %q, want %q", ticket.Date, tt.date)
+			}
+			gotTime := ""
+			if ticket.Time != nil {
+				gotTime = ticket.Time.String()
+			}
+			if gotTime != tt.time {
+				t.Errorf("time = %q, want %q", gotTime, tt.time)
+			}
+
+			gotSeat := ""
+			for _, field := range ticket.Fields {
+				if field.Subtitle == "좌석" {
+					gotSeat = field.Content
+				}
+			}
+			if gotSeat != tt.seat {
+				t.Errorf("seat = %q, want %q", gotSeat, tt.seat)
+			}
+
+			if !reflect.DeepEqual(draft.Confidence, tt.confidence) {
+				t.Errorf("confidence = %+v, want %+v", draft.Confidence, tt.confidence)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package usecase
+
+import "go.opentelemetry.io/otel"
+
+// tracer는 usecase 메서드마다 스팬을 만듭니다. 스팬 이름은 인터페이스 이름과 메서드 이름입니다(TicketUsecase.CreateTicket).
+var tracer = otel.Tracer("github.com/doyeon0307/tickit-backend/usecase")
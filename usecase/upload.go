@@ -0,0 +1,461 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/doyeon0307/tickit-backend/common"
+	"github.com/doyeon0307/tickit-backend/domain"
+	"github.com/doyeon0307/tickit-backend/dto"
+	"github.com/doyeon0307/tickit-backend/metrics"
+	"github.com/doyeon0307/tickit-backend/models"
+	"github.com/doyeon0307/tickit-backend/utils"
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/google/uuid"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	maxImageSize = 10 << 20
+	// sniffSize는 파일 형식 판별에 사용할 앞부분 크기입니다
+	sniffSize = 3072
+	// uploadURLExpiry는 업로드 URL의 유효 기간입니다
+	uploadURLExpiry = 15 * time.Minute
+	// storageTimeout은 저장소에서 이미지를 확인하거나 올리거나 읽는 작업 하나에 허용하는 시간입니다.
+	// 데이터베이스 조회는 이와 별개로 MONGODB_OPERATION_TIMEOUT을 따릅니다.
+	storageTimeout = 30 * time.Second
+)
+
+// imageExtensions는 업로드를 허용하는 이미지 Content-Type과 확장자입니다
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+	"image/heic": ".heic",
+	"image/heif": ".heif",
+}
+
+type uploadUsecase struct {
+	storage     domain.ObjectStorage
+	uploadRepo  domain.UploadRepository
+	thumbnailer domain.ThumbnailQueue
+	quota       *StorageQuota
+}
+
+func NewUploadUsecase(storage domain.ObjectStorage, repo domain.UploadRepository, thumbnailer domain.ThumbnailQueue, quota *StorageQuota) domain.UploadUsecase {
+	return &uploadUsecase{
+		storage:     storage,
+		uploadRepo:  repo,
+		thumbnailer: thumbnailer,
+		quota:       quota,
+	}
+}
+
+func imageTooLargeError() error {
+	return &common.AppError{
+		Code: common.ErrTooLarge,
+		Key:  "upload.too_large",
+		Args: []any{maxImageSize >> 20},
+	}
+}
+
+func (u uploadUsecase) IssuePresignedUrl(ctx context.Context, userId, contentType string, size int64) (*dto.S3UrlDTO, error) {
+	ctx, span := tracer.Start(ctx, "UploadUsecase.IssuePresignedUrl")
+	defer span.End()
+
+	ext, ok := imageExtensions[contentType]
+	if !ok {
+		return nil, &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "upload.type_unsupported",
+		}
+	}
+	if size <= 0 {
+		return nil, &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "upload.empty",
+		}
+	}
+	if size > maxImageSize {
+		return nil, imageTooLargeError()
+	}
+
+	key := utils.ImageKeyPrefix(userId) + uuid.New().String() + ext
+
+	// 확인 전에 올라온 파일도 저장 공간을 차지하므로 URL을 발급할 때 예약합니다
+	if err := u.reserve(ctx, userId, key, contentType, size, 0); err != nil {
+		return nil, err
+	}
+
+	url, err := u.storage.PresignPut(ctx, key, contentType, size, uploadURLExpiry)
+	if err != nil {
+		u.releaseUpload(ctx, key)
+		return nil, common.ServerError(ctx, "upload.url_failed", err)
+	}
+	metrics.PresignedURLIssued(metrics.PresignUpload)
+
+	return &dto.S3UrlDTO{
+		Url:         url,
+		Key:         key,
+		ContentType: contentType,
+		Size:        size,
+	}, nil
+}
+
+func (u uploadUsecase) ConfirmUpload(ctx context.Context, userId, key string) (*dto.UploadDTO, error) {
+	ctx, span := tracer.Start(ctx, "UploadUsecase.ConfirmUpload")
+	defer span.End()
+
+	if !utils.OwnsImage(userId, key) {
+		return nil, &common.AppError{
+			Code: common.ErrForbidden,
+			Key:  "image.not_owner",
+		}
+	}
+	key = utils.ImageKey(key)
+
+	ctx, cancel := context.WithTimeout(ctx, storageTimeout)
+	defer cancel()
+
+	// URL을 발급할 때 예약한 크기입니다. 확인을 마친 업로드를 다시 확인하는 경우 기존 크기입니다.
+	var reserved int64
+	upload, err := u.uploadRepo.GetByKey(ctx, key)
+	if err == nil {
+		reserved = upload.Size
+	} else if !common.HasCode(err, common.ErrNotFound) {
+		return nil, err
+	}
+
+	object, err := u.storage.Head(ctx, key)
+	if err != nil {
+		return nil, &common.AppError{
+			Code: common.ErrNotFound,
+			Key:  "upload.not_found",
+			Err:  err,
+		}
+	}
+
+	head, err := u.storage.ReadPrefix(ctx, key, sniffSize)
+	if err != nil {
+		return nil, common.ServerError(ctx, "upload.read_failed", err)
+	}
+
+	// 선언된 Content-Type이 아니라 실제 내용으로 이미지 여부를 판별합니다
+	detected := mimetype.Detect(head).String()
+	detected = strings.TrimSpace(strings.Split(detected, ";")[0])
+	if _, ok := imageExtensions[detected]; !ok {
+		u.discardObject(ctx, key)
+		return nil, &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "upload.not_image",
+			Err:  fmt.Errorf("detected %s, %d bytes", detected, object.Size),
+		}
+	}
+	if object.Size > maxImageSize {
+		u.discardObject(ctx, key)
+		return nil, imageTooLargeError()
+	}
+
+	// 예약 없이 발급된 URL이거나 예약보다 큰 파일이면 모자란 만큼 더 예약합니다
+	if object.Size > reserved {
+		if err := u.reserve(ctx, userId, key, detected, object.Size, reserved); err != nil {
+			if common.HasCode(err, common.ErrQuotaExceeded) {
+				u.discardObject(ctx, key)
+			}
+			return nil, err
+		}
+		reserved = object.Size
+	}
+
+	// 여기서 실패하면 예약 기록이 남고, 미사용 이미지 정리에서 파일과 함께 지워지며 반환됩니다
+	size, err := u.stripStoredLocation(ctx, key, detected, object.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := u.register(ctx, userId, key, detected, size)
+	if err != nil {
+		return nil, err
+	}
+	u.quota.Release(ctx, userId, reserved-size)
+	return result, nil
+}
+
+// reserve는 key의 업로드에 size 바이트의 저장 공간을 예약하고 확인 전 기록을 남깁니다.
+// already는 이미 예약해 둔 크기로, 모자란 만큼만 더 예약합니다.
+func (u uploadUsecase) reserve(ctx context.Context, userId, key, contentType string, size, already int64) error {
+	if err := u.quota.Reserve(ctx, userId, size-already); err != nil {
+		return err
+	}
+
+	pending := &models.Upload{
+		UserId:      userId,
+		Key:         key,
+		ContentType: contentType,
+		Size:        size,
+		ReservedAt:  time.Now(),
+	}
+	if err := u.uploadRepo.Save(ctx, pending); err != nil {
+		u.quota.Release(ctx, userId, size-already)
+		return err
+	}
+	return nil
+}
+
+// releaseUpload는 key의 업로드 기록을 지우고 예약했던 저장 공간을 돌려놓습니다
+func (u uploadUsecase) releaseUpload(ctx context.Context, key string) {
+	upload, err := u.uploadRepo.DeleteByKey(context.WithoutCancel(ctx), key)
+	if err != nil {
+		slog.WarnContext(ctx, "업로드 기록 삭제 실패", "key", key, "error", err)
+		return
+	}
+	if upload != nil {
+		u.quota.Release(ctx, upload.UserId, upload.Size)
+	}
+}
+
+// stripStoredLocation은 저장소에 올라온 이미지의 촬영 위치를 지우고 다시 저장한 뒤 파일 크기를 반환합니다.
+// 확인을 마친 이미지만 티켓·일정에 사용할 수 있으므로, 사용되는 모든 이미지에는 위치 정보가 남지 않습니다.
+func (u uploadUsecase) stripStoredLocation(ctx context.Context, key, contentType string, size int64) (int64, error) {
+	data, err := u.storage.Get(ctx, key)
+	if err != nil {
+		return 0, common.ServerError(ctx, "upload.read_failed", err)
+	}
+
+	stripped, changed := utils.StripLocation(data, contentType)
+	if !changed {
+		return size, nil
+	}
+	if err := u.storage.Put(ctx, key, contentType, bytes.NewReader(stripped), int64(len(stripped))); err != nil {
+		return 0, common.ServerError(ctx, "upload.save_failed", err)
+	}
+	return int64(len(stripped)), nil
+}
+
+// discardObject는 확인을 통과하지 못한 업로드 파일을 지우고 예약했던 저장 공간을 돌려놓습니다.
+// 파일 삭제에 실패해도 미사용 이미지 정리에서 다시 지워지므로 로그만 남깁니다.
+func (u uploadUsecase) discardObject(ctx context.Context, key string) {
+	if err := u.storage.Delete(context.WithoutCancel(ctx), key); err != nil {
+		slog.WarnContext(ctx, "업로드 파일 삭제 실패", "key", key, "error", err)
+	}
+	u.releaseUpload(ctx, key)
+}
+
+func (u uploadUsecase) UploadImage(ctx context.Context, userId string, body io.Reader) (*dto.UploadDTO, error) {
+	ctx, span := tracer.Start(ctx, "UploadUsecase.UploadImage")
+	defer span.End()
+
+	// 앞부분만 먼저 읽어 이미지가 아니면 나머지를 받지 않고 거부합니다
+	head := make([]byte, sniffSize)
+	n, err := io.ReadFull(body, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, receiveError(err)
+	}
+	head = head[:n]
+	if n == 0 {
+		return nil, &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "upload.empty",
+		}
+	}
+
+	detected := mimetype.Detect(head).String()
+	detected = strings.TrimSpace(strings.Split(detected, ";")[0])
+	ext, ok := imageExtensions[detected]
+	if !ok {
+		return nil, &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "upload.not_supported_image",
+			Err:  fmt.Errorf("detected %s", detected),
+		}
+	}
+
+	// 나머지는 메모리에 모두 올리지 않도록 임시 파일로 받습니다.
+	// 한도보다 1바이트 더 받아 보면 전체를 받지 않고도 크기 초과를 알 수 있습니다.
+	file, err := os.CreateTemp("", "tickit-upload-*")
+	if err != nil {
+		return nil, common.ServerError(ctx, "upload.save_failed", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	size, err := io.Copy(file, io.LimitReader(io.MultiReader(bytes.NewReader(head), body), maxImageSize+1))
+	if err != nil {
+		return nil, receiveError(err)
+	}
+	if size > maxImageSize {
+		return nil, imageTooLargeError()
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, common.ServerError(ctx, "upload.save_failed", err)
+	}
+
+	// 요청 본문을 다 받은 뒤부터 기한을 잽니다
+	ctx, cancel := context.WithTimeout(ctx, storageTimeout)
+	defer cancel()
+
+	// Presigned URL 업로드와 같은 형식의 키를 사용합니다
+	key := utils.ImageKeyPrefix(userId) + uuid.New().String() + ext
+	if err := u.reserve(ctx, userId, key, detected, size, 0); err != nil {
+		return nil, err
+	}
+	if err := u.storage.Put(ctx, key, detected, file, size); err != nil {
+		u.releaseUpload(ctx, key)
+		return nil, common.ServerError(ctx, "upload.save_failed", err)
+	}
+
+	// Presigned URL 업로드와 같이 저장한 뒤 촬영 위치를 지웁니다.
+	// 여기서 실패하면 예약 기록이 남고, 미사용 이미지 정리에서 파일과 함께 지워지며 반환됩니다.
+	stripped, err := u.stripStoredLocation(ctx, key, detected, size)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := u.register(ctx, userId, key, detected, stripped)
+	if err != nil {
+		return nil, err
+	}
+	u.quota.Release(ctx, userId, size-stripped)
+	return result, nil
+}
+
+// receiveError는 요청 본문을 읽다 난 오류입니다
+func receiveError(err error) error {
+	return &common.AppError{
+		Code: common.ErrBadRequest,
+		Key:  "upload.receive_failed",
+		Err:  err,
+	}
+}
+
+func (u uploadUsecase) SuggestColors(ctx context.Context, userId, key string) (*dto.ColorSuggestionDTO, error) {
+	ctx, span := tracer.Start(ctx, "UploadUsecase.SuggestColors")
+	defer span.End()
+
+	if err := checkImageOwner(ctx, u.uploadRepo, userId, key); err != nil {
+		return nil, err
+	}
+	key = utils.ImageKey(key)
+
+	ctx, cancel := context.WithTimeout(ctx, storageTimeout)
+	defer cancel()
+
+	// 색상 분석에는 작은 이미지로 충분하므로 썸네일이 있으면 썸네일을 읽습니다
+	data, err := u.storage.Get(ctx, utils.ThumbnailKey(key, utils.ThumbnailWidths[0], ".jpg"))
+	if err != nil {
+		data, err = u.storage.Get(ctx, key)
+	}
+	if err != nil {
+		return nil, &common.AppError{
+			Code: common.ErrNotFound,
+			Key:  "image.not_found",
+			Err:  err,
+		}
+	}
+
+	img, _, err := utils.DecodeImage(data)
+	if err != nil {
+		return nil, decodeError(err, "image.colors_unsupported")
+	}
+
+	palette := utils.ExtractPalette(img, paletteSize)
+	if len(palette) == 0 {
+		return nil, &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "image.colors_not_found",
+		}
+	}
+	return suggestColors(palette), nil
+}
+
+// register는 확인된 업로드를 기록하고 썸네일 생성을 요청합니다
+func (u uploadUsecase) register(ctx context.Context, userId, key, contentType string, size int64) (*dto.UploadDTO, error) {
+	upload := &models.Upload{
+		UserId:      userId,
+		Key:         key,
+		ContentType: contentType,
+		Size:        size,
+		ConfirmedAt: time.Now(),
+	}
+	if err := u.uploadRepo.Save(ctx, upload); err != nil {
+		return nil, err
+	}
+
+	// 썸네일이 준비되기 전까지는 목록에서도 원본 이미지를 사용합니다
+	u.thumbnailer.Enqueue(key, contentType)
+
+	return &dto.UploadDTO{
+		Key:         key,
+		ContentType: contentType,
+		Size:        size,
+	}, nil
+}
+
+// decodeError는 utils.DecodeImage의 오류를 응답할 오류로 바꿉니다. 해상도가 너무 큰 경우 외에는 key의 메시지를 사용합니다.
+func decodeError(err error, key string) error {
+	if errors.Is(err, utils.ErrImageTooLarge) {
+		return &common.AppError{
+			Code: common.ErrBadRequest,
+			Key:  "image.too_many_pixels",
+			Args: []any{utils.MaxDecodePixels / 1_000_000},
+			Err:  err,
+		}
+	}
+	return &common.AppError{
+		Code: common.ErrBadRequest,
+		Key:  key,
+		Err:  err,
+	}
+}
+
+// checkImageOwner는 image가 요청한 사용자의 경로에 있고 ConfirmUpload로 확인을 마친 업로드인지 확인합니다.
+// 경로만 맞고 확인 기록이 없는 객체는 크기와 형식을 검사하지 않았으므로 사용할 수 없습니다.
+func checkImageOwner(ctx context.Context, uploadRepo domain.UploadRepository, userId, image string) error {
+	if !utils.OwnsImage(userId, image) {
+		return &common.AppError{
+			Code: common.ErrForbidden,
+			Key:  "image.not_owner",
+		}
+	}
+
+	upload, err := uploadRepo.GetByKey(ctx, utils.ImageKey(image))
+	if err != nil {
+		return err
+	}
+	if upload.UserId != userId {
+		return &common.AppError{
+			Code: common.ErrForbidden,
+			Key:  "image.not_owner",
+		}
+	}
+	// URL만 발급받고 확인하지 않은 업로드입니다
+	if !upload.Confirmed() {
+		return &common.AppError{
+			Code: common.ErrNotFound,
+			Key:  "upload.not_confirmed",
+		}
+	}
+	return nil
+}
+
+// imageOwnerCheck는 티켓·일정에 새로 추가하는 이미지를 검사합니다
+type imageOwnerCheck func(image string) error
+
+// ownedImages는 checkImageOwner로 userId의 이미지인지 확인하는 imageOwnerCheck를 만듭니다
+func ownedImages(ctx context.Context, uploadRepo domain.UploadRepository, userId string) imageOwnerCheck {
+	return func(image string) error {
+		if image == "" {
+			return nil
+		}
+		return checkImageOwner(ctx, uploadRepo, userId, image)
+	}
+}
@@ -7,48 +7,67 @@ import (
 	"github.com/doyeon0307/tickit-backend/common"
 	"github.com/doyeon0307/tickit-backend/domain"
 	"github.com/doyeon0307/tickit-backend/dto"
+	"github.com/doyeon0307/tickit-backend/i18n"
 	"github.com/doyeon0307/tickit-backend/models"
 	"github.com/doyeon0307/tickit-backend/service"
+	"github.com/doyeon0307/tickit-backend/utils"
 )
 
 type userUsecase struct {
 	userRepo domain.UserRepository
+	quota    *StorageQuota
+	locales  *localeCache
 }
 
-func NewUserUsecase(repo domain.UserRepository) domain.UserUsecase {
+func NewUserUsecase(repo domain.UserRepository, quota *StorageQuota) domain.UserUsecase {
 	return &userUsecase{
 		userRepo: repo,
+		quota:    quota,
+		locales:  newLocaleCache(),
 	}
 }
 
-func (u userUsecase) GetProfile(id string) (*dto.KakaoProfile, error) {
-	model, err := u.userRepo.GetById(context.Background(), id)
+func (u userUsecase) GetProfile(ctx context.Context, id string) (*dto.KakaoProfile, error) {
+	ctx, span := tracer.Start(ctx, "UserUsecase.GetProfile")
+	defer span.End()
+
+	model, err := u.userRepo.GetById(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	timeZone := model.TimeZone
+	if timeZone == "" {
+		timeZone = utils.DefaultTimeZone
+	}
+
 	profile := &dto.KakaoProfile{
 		NickName: model.Name,
+		TimeZone: timeZone,
+		Locale:   model.Locale,
 	}
 	return profile, nil
 }
 
-func (u userUsecase) CreateUser(idToken string, accessToken string) (string, error) {
+func (u userUsecase) CreateUser(ctx context.Context, idToken string, accessToken string) (string, error) {
+	ctx, span := tracer.Start(ctx, "UserUsecase.CreateUser")
+	defer span.End()
+
 	oauthId, err := service.GetOAuthIdFromKakao(idToken)
 	if err != nil {
 		return "", &common.AppError{
-			Code:    common.ErrNotFound,
-			Message: "ID Token: 카카오로부터 사용자 정보를 불러오는데 실패했습니다",
-			Err:     err,
+			Code: common.ErrNotFound,
+			Key:  "kakao.id_token_user_failed",
+			Err:  err,
 		}
 	}
 
-	info, err := service.GetUserInfoFromKakao(accessToken)
+	info, err := service.GetUserInfoFromKakao(ctx, accessToken)
 	if err != nil {
 		return "", &common.AppError{
-			Code:    common.ErrNotFound,
-			Message: "Access Token: 카카오로부터 사용자 정보를 불러오는데 실패했습니다",
-			Err:     err,
+			Code: common.ErrNotFound,
+			Key:  "kakao.access_token_user_failed",
+			Err:  err,
 		}
 	}
 	name := info.NickName
@@ -58,44 +77,119 @@ func (u userUsecase) CreateUser(idToken string, accessToken string) (string, err
 		Name:    name,
 	}
 
-	id, err := u.userRepo.Create(context.Background(), user)
+	id, err := u.userRepo.Create(ctx, user)
 	return id, err
 }
 
-func (u userUsecase) DeleteUser(id string) error {
-	return u.userRepo.Delete(context.Background(), id)
+func (u userUsecase) DeleteUser(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "UserUsecase.DeleteUser")
+	defer span.End()
+
+	return u.userRepo.Delete(ctx, id)
 }
 
-func (u *userUsecase) GetUserByOAuthId(oauthId string) (*models.User, error) {
-	user, err := u.userRepo.GetByOAuthId(context.Background(), oauthId)
+func (u *userUsecase) GetUserByOAuthId(ctx context.Context, oauthId string) (*models.User, error) {
+	ctx, span := tracer.Start(ctx, "UserUsecase.GetUserByOAuthId")
+	defer span.End()
+
+	user, err := u.userRepo.GetByOAuthId(ctx, oauthId)
 	if err != nil {
 		return nil, err
 	}
 	return user, nil
 }
 
-func (u *userUsecase) SaveRefreshToken(userId string, refreshToken string, expiryTime time.Time) error {
-	err := u.userRepo.SaveRefreshToken(context.Background(), userId, refreshToken, expiryTime)
+func (u *userUsecase) SaveRefreshToken(ctx context.Context, userId string, refreshToken string, expiryTime time.Time) error {
+	ctx, span := tracer.Start(ctx, "UserUsecase.SaveRefreshToken")
+	defer span.End()
+
+	err := u.userRepo.SaveRefreshToken(ctx, userId, refreshToken, expiryTime)
 	return err
 }
 
-func (u *userUsecase) ValidateStoredRefreshToken(userId string, refreshToken string) (bool, error) {
-	storedToken, err := u.userRepo.GetRefreshToken(context.Background(), userId)
+func (u *userUsecase) ValidateStoredRefreshToken(ctx context.Context, userId string, refreshToken string) (bool, error) {
+	ctx, span := tracer.Start(ctx, "UserUsecase.ValidateStoredRefreshToken")
+	defer span.End()
+
+	storedToken, err := u.userRepo.GetRefreshToken(ctx, userId)
 	if err != nil {
-		return false, &common.AppError{
-			Code:    common.ErrServer,
-			Message: "Refresh Token 조회에 실패했습니다",
-			Err:     err,
-		}
+		return false, common.ServerError(ctx, "auth.refresh_token_load_failed", err)
 	}
 
 	return storedToken == refreshToken, nil
 }
 
-func (u *userUsecase) WithdrawUser(userId string) error {
-	return u.userRepo.DeleteUser(context.Background(), userId)
+func (u *userUsecase) WithdrawUser(ctx context.Context, userId string) error {
+	ctx, span := tracer.Start(ctx, "UserUsecase.WithdrawUser")
+	defer span.End()
+
+	if err := u.userRepo.DeleteUser(ctx, userId); err != nil {
+		return err
+	}
+	u.locales.delete(userId)
+	return nil
+}
+
+func (u *userUsecase) Logout(ctx context.Context, userId string) error {
+	ctx, span := tracer.Start(ctx, "UserUsecase.Logout")
+	defer span.End()
+
+	return u.userRepo.RemoveRefreshToken(ctx, userId)
+}
+
+func (u *userUsecase) UpdateTimeZone(ctx context.Context, userId string, timeZone string) error {
+	ctx, span := tracer.Start(ctx, "UserUsecase.UpdateTimeZone")
+	defer span.End()
+
+	if _, err := utils.LoadIANATimeZone(timeZone); err != nil {
+		return common.FieldValidationError("timeZone", "timezone", "validation.time_zone")
+	}
+	return u.userRepo.UpdateTimeZone(ctx, userId, timeZone)
+}
+
+func (u *userUsecase) UpdateLocale(ctx context.Context, userId string, locale string) (string, error) {
+	ctx, span := tracer.Start(ctx, "UserUsecase.UpdateLocale")
+	defer span.End()
+
+	// en-US처럼 지역이 붙은 값은 언어만 저장합니다
+	if locale != "" {
+		parsed, ok := i18n.ParseLocale(locale)
+		if !ok {
+			return "", common.FieldValidationError("locale", "oneof", "validation.locale")
+		}
+		locale = string(parsed)
+	}
+	if err := u.userRepo.UpdateLocale(ctx, userId, locale); err != nil {
+		return "", err
+	}
+	// 이 서버에서는 바꾼 언어가 바로 반영되도록 보관 중인 값을 바꿉니다
+	parsed, ok := i18n.ParseLocale(locale)
+	u.locales.set(userId, parsed, ok, time.Now())
+	return locale, nil
 }
 
-func (u *userUsecase) Logout(userId string) error {
-	return u.userRepo.RemoveRefreshToken(context.Background(), userId)
+// GetLocale은 인증된 요청마다 호출되므로 읽은 언어를 localeCacheTTL 동안 보관합니다
+func (u *userUsecase) GetLocale(ctx context.Context, userId string) (i18n.Locale, bool, error) {
+	ctx, span := tracer.Start(ctx, "UserUsecase.GetLocale")
+	defer span.End()
+
+	now := time.Now()
+	if locale, ok, found := u.locales.get(userId, now); found {
+		return locale, ok, nil
+	}
+
+	model, err := u.userRepo.GetById(ctx, userId)
+	if err != nil {
+		return "", false, err
+	}
+	locale, ok := i18n.ParseLocale(model.Locale)
+	u.locales.set(userId, locale, ok, now)
+	return locale, ok, nil
+}
+
+func (u userUsecase) GetStorageUsage(ctx context.Context, userId string) (*dto.StorageUsageDTO, error) {
+	ctx, span := tracer.Start(ctx, "UserUsecase.GetStorageUsage")
+	defer span.End()
+
+	return u.quota.Usage(ctx, userId)
 }
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MinContrastRatio는 WCAG AA 기준 일반 텍스트의 최소 명도 대비입니다
+const MinContrastRatio = 4.5
+
+// ParseColor는 티켓 색상 문자열을 읽습니다.
+// 앱에서 쓰는 0xAARRGGBB 형식과 #RRGGBB, #AARRGGBB 형식을 받으며, 6자리면 불투명한 색으로 봅니다.
+func ParseColor(value string) (color.NRGBA, error) {
+	hex := strings.TrimSpace(value)
+	switch {
+	case strings.HasPrefix(hex, "0x"), strings.HasPrefix(hex, "0X"):
+		hex = hex[2:]
+	case strings.HasPrefix(hex, "#"):
+		hex = hex[1:]
+	}
+	if len(hex) == 6 {
+		hex = "ff" + hex
+	}
+	if len(hex) != 8 {
+		return color.NRGBA{}, fmt.Errorf("invalid color %q", value)
+	}
+
+	argb, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.NRGBA{}, fmt.Errorf("invalid color %q", value)
+	}
+	return color.NRGBA{
+		A: uint8(argb >> 24),
+		R: uint8(argb >> 16),
+		G: uint8(argb >> 8),
+		B: uint8(argb),
+	}, nil
+}
+
+// FormatColor는 색상을 앱에서 쓰는 0xAARRGGBB 형식으로 바꿉니다
+func FormatColor(c color.NRGBA) string {
+	return fmt.Sprintf("0x%02X%02X%02X%02X", c.A, c.R, c.G, c.B)
+}
+
+// blend는 반투명한 fg를 불투명한 bg 위에 겹친 색입니다
+func blend(fg, bg color.NRGBA) color.NRGBA {
+	a := float64(fg.A) / 255
+	mix := func(f, b uint8) uint8 {
+		return uint8(math.Round(float64(f)*a + float64(b)*(1-a)))
+	}
+	return color.NRGBA{R: mix(fg.R, bg.R), G: mix(fg.G, bg.G), B: mix(fg.B, bg.B), A: 255}
+}
+
+// RelativeLuminance는 WCAG 2.x 정의에 따른 상대 휘도(0~1)입니다
+func RelativeLuminance(c color.NRGBA) float64 {
+	channel := func(v uint8) float64 {
+		s := float64(v) / 255
+		if s <= 0.03928 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*channel(c.R) + 0.7152*channel(c.G) + 0.0722*channel(c.B)
+}
+
+// ContrastRatio는 배경색 위에 글자색을 놓았을 때의 명도 대비(1~21)입니다.
+// 반투명한 배경은 흰 화면 위에, 반투명한 글자는 배경 위에 겹쳐서 계산합니다.
+func ContrastRatio(background, foreground color.NRGBA) float64 {
+	bg := blend(background, color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+	fg := blend(foreground, bg)
+
+	l1, l2 := RelativeLuminance(bg), RelativeLuminance(fg)
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
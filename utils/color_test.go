@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    color.NRGBA
+		wantErr bool
+	}{
+		{value: "0xFF112233", want: color.NRGBA{A: 0xFF, R: 0x11, G: 0x22, B: 0x33}},
+		{value: "0X80112233", want: color.NRGBA{A: 0x80, R: 0x11, G: 0x22, B: 0x33}},
+		{value: "#112233", want: color.NRGBA{A: 0xFF, R: 0x11, G: 0x22, B: 0x33}},
+		{value: "#00aabbcc", want: color.NRGBA{A: 0x00, R: 0xAA, G: 0xBB, B: 0xCC}},
+		{value: "  #FFFFFF ", want: color.NRGBA{A: 0xFF, R: 0xFF, G: 0xFF, B: 0xFF}},
+		{value: "abcdef", want: color.NRGBA{A: 0xFF, R: 0xAB, G: 0xCD, B: 0xEF}},
+		{value: "", wantErr: true},
+		{value: "#FFF", wantErr: true},
+		{value: "0x1122334", wantErr: true},
+		{value: "#GG2233", wantErr: true},
+		{value: "0x+1223344", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseColor(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseColor(%q) = %v, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseColor(%q) error: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseColor(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+			if back, _ := ParseColor(FormatColor(got)); back != got {
+				t.Errorf("FormatColor(%v) = %q, which does not parse back", got, FormatColor(got))
+			}
+		})
+	}
+}
+
+func TestContrastRatio(t *testing.T) {
+	tests := []struct {
+		name       string
+		background string
+		foreground string
+		want       float64
+	}{
+		{name: "흰 배경에 검은 글자", background: "#FFFFFF", foreground: "#000000", want: 21},
+		{name: "순서를 바꿔도 같습니다", background: "#000000", foreground: "#FFFFFF", want: 21},
+		{name: "같은 색", background: "#3366CC", foreground: "#3366CC", want: 1},
+		{name: "AA 기준에 조금 못 미치는 회색", background: "#FFFFFF", foreground: "#777777", want: 4.4781},
+		{name: "AA 기준을 넘는 회색", background: "#FFFFFF", foreground: "#767676", want: 4.5422},
+		{name: "파란 배경에 노란 글자", background: "#0000FF", foreground: "#FFFF00", want: 8.0016},
+		{name: "투명한 배경은 흰 화면 위에 겹칩니다", background: "0x00000000", foreground: "#000000", want: 21},
+		{name: "반투명한 글자는 배경 위에 겹칩니다", background: "#FFFFFF", foreground: "0x80000000", want: 4.0041},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			background, err := ParseColor(tt.background)
+			if err != nil {
+				t.Fatal(err)
+			}
+			foreground, err := ParseColor(tt.foreground)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := ContrastRatio(background, foreground)
+			if math.Abs(got-tt.want) > 0.001 {
+				t.Errorf("ContrastRatio(%s, %s) = %.4f, want %.4f", tt.background, tt.foreground, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,45 +1,20 @@
 package utils
 
 import (
-	"fmt"
-	"strconv"
-	"strings"
 	"time"
 )
 
-func SplitDateTime(dt time.Time) (string, string) {
-	date := dt.Format("2006-01-02")
-	ampm := "AM"
-	hour := dt.Hour()
-
-	if hour >= 12 {
-		ampm = "PM"
-		if hour > 12 {
-			hour -= 12
-		}
-	}
-	if hour == 0 {
-		hour = 12
-	}
-
-	time := fmt.Sprintf("%s-%02d-%02d", ampm, hour, dt.Minute())
-	return date, time
+// SplitDateTime은 시각을 loc 기준의 날짜와 시, 분으로 나눕니다
+func SplitDateTime(dt time.Time, loc *time.Location) (string, int, int) {
+	dt = dt.In(loc)
+	return dt.Format("2006-01-02"), dt.Hour(), dt.Minute()
 }
 
-func CombineDateTime(date string, timeStr string) (time.Time, error) {
-	parts := strings.Split(timeStr, "-")
-	ampm, hourStr, minStr := parts[0], parts[1], parts[2]
-
-	hour, _ := strconv.Atoi(hourStr)
-	min, _ := strconv.Atoi(minStr)
-
-	if ampm == "PM" && hour != 12 {
-		hour += 12
+// CombineDateTime은 loc 기준의 날짜와 시, 분을 하나의 시각으로 합칩니다
+func CombineDateTime(date string, hour, minute int, loc *time.Location) (time.Time, error) {
+	day, err := time.ParseInLocation("2006-01-02", date, loc)
+	if err != nil {
+		return time.Time{}, err
 	}
-	if ampm == "AM" && hour == 12 {
-		hour = 0
-	}
-
-	dateTimeStr := fmt.Sprintf("%s %02d:%02d:00", date, hour, min)
-	return time.Parse("2006-01-02 15:04:05", dateTimeStr)
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc), nil
 }
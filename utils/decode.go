@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+)
+
+// MaxDecodePixels는 디코딩을 허용하는 최대 화소 수입니다.
+// 압축률이 높은 작은 파일도 디코딩하면 화소 수만큼 메모리를 사용하므로 헤더로 크기를 먼저 확인합니다.
+const MaxDecodePixels = 40_000_000
+
+// ErrImageTooLarge는 이미지의 화소 수가 MaxDecodePixels를 넘을 때 반환됩니다
+var ErrImageTooLarge = errors.New("이미지 해상도가 너무 큽니다")
+
+// DecodeImage는 헤더의 가로·세로 크기를 확인한 뒤 이미지를 디코딩합니다.
+// 화소 수가 MaxDecodePixels를 넘으면 디코딩하지 않고 ErrImageTooLarge를 반환합니다.
+func DecodeImage(data []byte) (image.Image, string, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+	if config.Width <= 0 || config.Height <= 0 || int64(config.Width)*int64(config.Height) > MaxDecodePixels {
+		return nil, format, fmt.Errorf("%w: %dx%d", ErrImageTooLarge, config.Width, config.Height)
+	}
+	return image.Decode(bytes.NewReader(data))
+}
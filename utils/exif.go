@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+const (
+	exifOrientationTag = 0x0112
+	exifGPSInfoTag     = 0x8825
+)
+
+// exifTypeSizes는 TIFF 필드 타입별 값 하나의 크기입니다
+var exifTypeSizes = map[uint16]uint32{
+	1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8,
+}
+
+// jpegExif는 JPEG 파일 안의 EXIF(TIFF) 영역을 찾습니다. 찾지 못하면 nil을 반환합니다.
+func jpegExif(data []byte) []byte {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return nil
+		}
+		marker := data[i+1]
+		// 이미지 데이터가 시작되면 더 이상 메타데이터가 없습니다
+		if marker == 0xDA || marker == 0xD9 {
+			return nil
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			return nil
+		}
+		segment := data[i+4 : end]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return segment[6:]
+		}
+		i = end
+	}
+	return nil
+}
+
+type tiffReader struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+func newTiffReader(data []byte) *tiffReader {
+	if len(data) < 8 {
+		return nil
+	}
+	switch string(data[:2]) {
+	case "II":
+		return &tiffReader{data: data, order: binary.LittleEndian}
+	case "MM":
+		return &tiffReader{data: data, order: binary.BigEndian}
+	}
+	return nil
+}
+
+func (r *tiffReader) uint16At(offset uint32) (uint16, bool) {
+	if uint64(offset)+2 > uint64(len(r.data)) {
+		return 0, false
+	}
+	return r.order.Uint16(r.data[offset:]), true
+}
+
+func (r *tiffReader) uint32At(offset uint32) (uint32, bool) {
+	if uint64(offset)+4 > uint64(len(r.data)) {
+		return 0, false
+	}
+	return r.order.Uint32(r.data[offset:]), true
+}
+
+// findEntry는 IFD에서 tag에 해당하는 12바이트 항목의 위치를 찾습니다
+func (r *tiffReader) findEntry(ifd uint32, tag uint16) (uint32, bool) {
+	count, ok := r.uint16At(ifd)
+	if !ok {
+		return 0, false
+	}
+	for i := uint32(0); i < uint32(count); i++ {
+		entry := ifd + 2 + i*12
+		entryTag, ok := r.uint16At(entry)
+		if !ok {
+			return 0, false
+		}
+		if entryTag == tag {
+			return entry, true
+		}
+	}
+	return 0, false
+}
+
+func (r *tiffReader) firstIFD() (uint32, bool) {
+	return r.uint32At(4)
+}
+
+// ExifOrientation은 JPEG EXIF의 회전 정보(1~8)를 반환합니다. 정보가 없으면 1을 반환합니다.
+func ExifOrientation(data []byte) int {
+	r := newTiffReader(jpegExif(data))
+	if r == nil {
+		return 1
+	}
+	ifd, ok := r.firstIFD()
+	if !ok {
+		return 1
+	}
+	entry, ok := r.findEntry(ifd, exifOrientationTag)
+	if !ok {
+		return 1
+	}
+	orientation, ok := r.uint16At(entry + 8)
+	if !ok || orientation < 1 || orientation > 8 {
+		return 1
+	}
+	return int(orientation)
+}
+
+// stripGPS는 EXIF(TIFF) 영역의 위치 정보를 0으로 덮어씁니다.
+// 영역 길이와 다른 메타데이터의 위치는 그대로 유지되며, 위치 정보를 지웠으면 true를 반환합니다.
+func stripGPS(tiff []byte) bool {
+	r := newTiffReader(tiff)
+	if r == nil {
+		return false
+	}
+	ifd, ok := r.firstIFD()
+	if !ok {
+		return false
+	}
+	entry, ok := r.findEntry(ifd, exifGPSInfoTag)
+	if !ok {
+		return false
+	}
+	gpsIFD, ok := r.uint32At(entry + 8)
+	if !ok {
+		return false
+	}
+	count, ok := r.uint16At(gpsIFD)
+	if !ok {
+		return false
+	}
+
+	for i := uint32(0); i < uint32(count); i++ {
+		field := gpsIFD + 2 + i*12
+		fieldType, ok := r.uint16At(field + 2)
+		if !ok {
+			break
+		}
+		valueCount, ok := r.uint32At(field + 4)
+		if !ok {
+			break
+		}
+		// 4바이트를 넘는 값은 항목 밖에 저장되어 있으므로 따로 지웁니다
+		size := uint64(exifTypeSizes[fieldType]) * uint64(valueCount)
+		if size > 4 {
+			offset, ok := r.uint32At(field + 8)
+			if ok && uint64(offset)+size <= uint64(len(r.data)) {
+				clear(r.data[offset : uint64(offset)+size])
+			}
+		}
+	}
+
+	end := uint64(gpsIFD) + 2 + uint64(count)*12
+	if end > uint64(len(r.data)) {
+		end = uint64(len(r.data))
+	}
+	clear(r.data[gpsIFD:end])
+	return true
+}
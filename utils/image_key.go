@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// LocalStoragePath는 로컬 저장소의 객체를 내려주는 서버 경로입니다
+const LocalStoragePath = "/storage/"
+
+// ImageKeyRoot는 모든 사용자 이미지가 저장되는 경로입니다
+const ImageKeyRoot = "users/"
+
+// ImageKeyPrefix는 사용자별 이미지가 저장되는 경로입니다
+func ImageKeyPrefix(userId string) string {
+	return ImageKeyRoot + userId + "/"
+}
+
+// imageHosts는 ImageKey가 객체 키를 꺼낼 URL의 호스트입니다
+var imageHosts = map[string]bool{}
+
+// SetImageHosts는 버킷과 로컬 저장소의 호스트를 등록합니다. 서버를 시작할 때 요청을 받기 전에 한 번 호출합니다.
+func SetImageHosts(hosts ...string) {
+	imageHosts = make(map[string]bool, len(hosts))
+	for _, host := range hosts {
+		imageHosts[strings.ToLower(host)] = true
+	}
+}
+
+// ImageKey는 이미지 값이 등록된 호스트의 URL이면 버킷 내 객체 키를 추출하고, 키라면 그대로 반환합니다.
+// 다른 호스트의 URL은 우리 저장소의 객체가 아니므로 그대로 반환합니다.
+func ImageKey(image string) string {
+	if strings.HasPrefix(image, "http://") || strings.HasPrefix(image, "https://") {
+		parsed, err := url.Parse(image)
+		if err != nil || !imageHosts[strings.ToLower(parsed.Host)] {
+			return image
+		}
+		// 로컬 저장소 URL은 경로 앞의 LocalStoragePath를 제외한 부분이 키입니다
+		return strings.TrimPrefix(strings.TrimPrefix(parsed.Path, LocalStoragePath), "/")
+	}
+	return image
+}
+
+// OwnsImage는 이미지가 사용자 경로 아래에 업로드된 것인지 확인합니다
+func OwnsImage(userId, image string) bool {
+	key := ImageKey(image)
+	return strings.HasPrefix(key, ImageKeyPrefix(userId)) && !strings.Contains(key, "..")
+}
+
+// ThumbnailWidths는 업로드된 이미지마다 생성하는 썸네일의 가로 크기입니다
+var ThumbnailWidths = []int{320, 640}
+
+// ThumbnailKey는 원본 키에서 썸네일 키를 만듭니다.
+// users/{userId}/{name}.jpg의 640px WebP 썸네일은 users/{userId}/thumbnails/{name}_w640.webp에 저장됩니다.
+func ThumbnailKey(key string, width int, ext string) string {
+	dir, file := path.Split(ImageKey(key))
+	name := strings.TrimSuffix(file, path.Ext(file))
+	return dir + "thumbnails/" + name + "_w" + strconv.Itoa(width) + ext
+}
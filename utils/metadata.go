@@ -0,0 +1,379 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+)
+
+var (
+	pngSignature = []byte("\x89PNG\r\n\x1a\n")
+	xmpNamespace = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	// xmpExtension은 64KB를 넘는 XMP가 이어서 저장되는 JPEG 세그먼트입니다
+	xmpExtension = []byte("http://ns.adobe.com/xmp/extension/\x00")
+)
+
+// StripLocation은 업로드된 이미지의 메타데이터에서 촬영 위치를 지우고, 바뀐 파일과 변경 여부를 반환합니다.
+// EXIF는 회전 정보를 유지하도록 GPS 항목만 0으로 덮어쓰고, 위치가 담길 수 있는 XMP와 텍스트 메타데이터는 통째로 지웁니다.
+// 지원하는 형식은 업로드를 허용하는 JPEG, PNG, WebP, HEIC/HEIF이며, 구조를 해석하지 못한 부분은 그대로 둡니다.
+func StripLocation(data []byte, contentType string) ([]byte, bool) {
+	switch contentType {
+	case "image/jpeg":
+		return stripJPEG(data)
+	case "image/png":
+		return stripPNG(data)
+	case "image/webp":
+		return stripWebP(data)
+	case "image/heic", "image/heif":
+		return data, stripHEIF(data)
+	}
+	return data, false
+}
+
+// stripJPEG는 APP1 EXIF의 GPS 항목을 지우고 XMP 세그먼트를 제거합니다
+func stripJPEG(data []byte) ([]byte, bool) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return data, false
+	}
+	changed := stripGPS(jpegExif(data))
+
+	out := append(make([]byte, 0, len(data)), data[:2]...)
+	i := 2
+	for i+4 <= len(data) {
+		if data[i] != 0xFF {
+			break
+		}
+		marker := data[i+1]
+		// 이미지 데이터가 시작되면 더 이상 메타데이터가 없습니다
+		if marker == 0xDA || marker == 0xD9 {
+			break
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			break
+		}
+		segment := data[i+4 : end]
+		if marker == 0xE1 && (bytes.HasPrefix(segment, xmpNamespace) || bytes.HasPrefix(segment, xmpExtension)) {
+			changed = true
+		} else {
+			out = append(out, data[i:end]...)
+		}
+		i = end
+	}
+	if !changed {
+		return data, false
+	}
+	return append(out, data[i:]...), true
+}
+
+// stripPNG는 eXIf 청크의 GPS 항목을 지우고 텍스트 청크(tEXt, zTXt, iTXt)를 제거합니다.
+// XMP와 일부 프로그램이 저장하는 EXIF 원본이 텍스트 청크에 담기기 때문입니다.
+func stripPNG(data []byte) ([]byte, bool) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return data, false
+	}
+
+	changed := false
+	out := append(make([]byte, 0, len(data)), pngSignature...)
+	i := len(pngSignature)
+	for i+12 <= len(data) {
+		length := binary.BigEndian.Uint32(data[i:])
+		end := uint64(i) + 12 + uint64(length)
+		if end > uint64(len(data)) {
+			break
+		}
+		chunkType := string(data[i+4 : i+8])
+		chunk := data[i:end]
+
+		switch chunkType {
+		case "tEXt", "zTXt", "iTXt":
+			changed = true
+		case "eXIf":
+			if stripGPS(chunk[8 : 8+length]) {
+				binary.BigEndian.PutUint32(chunk[8+length:], crc32.ChecksumIEEE(chunk[4:8+length]))
+				changed = true
+			}
+			out = append(out, chunk...)
+		default:
+			out = append(out, chunk...)
+		}
+
+		i = int(end)
+		if chunkType == "IEND" {
+			break
+		}
+	}
+	if !changed {
+		return data, false
+	}
+	return append(out, data[i:]...), true
+}
+
+// stripWebP는 EXIF 청크의 GPS 항목을 지우고 XMP 청크를 제거합니다. 제거한 뒤 VP8X의 XMP 표시와 RIFF 크기를 고칩니다.
+func stripWebP(data []byte) ([]byte, bool) {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return data, false
+	}
+
+	const vp8xXMPFlag = 0x04
+
+	changed := false
+	vp8x := -1
+	out := append(make([]byte, 0, len(data)), data[:12]...)
+	i := 12
+	for i+8 <= len(data) {
+		size := binary.LittleEndian.Uint32(data[i+4:])
+		// 청크 내용은 짝수 길이로 채워집니다
+		end := uint64(i) + 8 + uint64(size) + uint64(size&1)
+		if end > uint64(len(data)) {
+			break
+		}
+		chunkType := string(data[i : i+4])
+		body := data[i+8 : uint64(i)+8+uint64(size)]
+
+		switch chunkType {
+		case "XMP ":
+			changed = true
+		case "EXIF":
+			// 일부 프로그램은 JPEG처럼 Exif 식별자를 앞에 붙여 저장합니다
+			changed = stripGPS(bytes.TrimPrefix(body, []byte("Exif\x00\x00"))) || changed
+			out = append(out, data[i:end]...)
+		case "VP8X":
+			vp8x = len(out) + 8
+			out = append(out, data[i:end]...)
+		default:
+			out = append(out, data[i:end]...)
+		}
+		i = int(end)
+	}
+	if !changed {
+		return data, false
+	}
+
+	out = append(out, data[i:]...)
+	if vp8x >= 0 && vp8x < len(out) {
+		out[vp8x] &^= vp8xXMPFlag
+	}
+	binary.LittleEndian.PutUint32(out[4:], uint32(len(out)-8))
+	return out, true
+}
+
+// heifItem은 HEIF의 iloc 상자에 기록된 항목 하나의 파일 내 위치입니다
+type heifItem struct {
+	offset uint64
+	length uint64
+}
+
+// stripHEIF는 HEIC/HEIF 파일의 Exif 항목에서 GPS 항목을 지우고 XMP 항목을 공백으로 덮어씁니다.
+// 항목의 위치가 iloc 상자에 절대 위치로 기록되어 있으므로 파일 길이를 바꾸지 않고 제자리에서 고칩니다.
+func stripHEIF(data []byte) bool {
+	meta, ok := findBox(data, "meta")
+	// meta는 FullBox이므로 버전과 플래그 4바이트 뒤에 하위 상자가 있습니다
+	if !ok || len(meta) < 4 {
+		return false
+	}
+	meta = meta[4:]
+
+	iinf, ok := findBox(meta, "iinf")
+	if !ok {
+		return false
+	}
+	iloc, ok := findBox(meta, "iloc")
+	if !ok {
+		return false
+	}
+	exifItems, xmpItems := heifMetadataItems(iinf)
+	if len(exifItems) == 0 && len(xmpItems) == 0 {
+		return false
+	}
+	locations := heifItemLocations(iloc)
+
+	changed := false
+	for id := range exifItems {
+		item, ok := heifItemData(data, locations, id)
+		if !ok || len(item) < 4 {
+			continue
+		}
+		// Exif 항목은 TIFF 헤더까지의 거리 4바이트로 시작합니다
+		tiffOffset := uint64(binary.BigEndian.Uint32(item)) + 4
+		if tiffOffset >= uint64(len(item)) {
+			continue
+		}
+		changed = stripGPS(item[tiffOffset:]) || changed
+	}
+	for id := range xmpItems {
+		item, ok := heifItemData(data, locations, id)
+		if !ok {
+			continue
+		}
+		for i := range item {
+			item[i] = ' '
+		}
+		changed = true
+	}
+	return changed
+}
+
+// heifItemData는 항목 id의 내용입니다. 위치와 길이는 파일에 적힌 값이므로 더하면 넘칠 수 있어 빼서 비교합니다.
+func heifItemData(data []byte, locations map[uint32]heifItem, id uint32) ([]byte, bool) {
+	location, ok := locations[id]
+	if !ok || location.offset > uint64(len(data)) || location.length > uint64(len(data))-location.offset {
+		return nil, false
+	}
+	return data[location.offset : location.offset+location.length], true
+}
+
+// findBox는 ISOBMFF 상자 목록에서 boxType 상자의 내용을 찾습니다
+func findBox(data []byte, boxType string) ([]byte, bool) {
+	for i := uint64(0); i+8 <= uint64(len(data)); {
+		size := uint64(binary.BigEndian.Uint32(data[i:]))
+		header := uint64(8)
+		switch size {
+		case 0:
+			size = uint64(len(data)) - i
+		case 1:
+			if i+16 > uint64(len(data)) {
+				return nil, false
+			}
+			size = binary.BigEndian.Uint64(data[i+8:])
+			header = 16
+		}
+		// size는 파일에 적힌 값이므로 i에 더하면 넘칠 수 있어 남은 길이와 비교합니다
+		if size < header || size > uint64(len(data))-i {
+			return nil, false
+		}
+		if string(data[i+4:i+8]) == boxType {
+			return data[i+header : i+size], true
+		}
+		i += size
+	}
+	return nil, false
+}
+
+// heifMetadataItems는 iinf 상자에서 Exif 항목과 XMP 항목의 아이디를 찾습니다
+func heifMetadataItems(iinf []byte) (map[uint32]bool, map[uint32]bool) {
+	exifItems, xmpItems := map[uint32]bool{}, map[uint32]bool{}
+	if len(iinf) < 6 {
+		return exifItems, xmpItems
+	}
+	entries := iinf[6:]
+	if iinf[0] != 0 {
+		if len(iinf) < 8 {
+			return exifItems, xmpItems
+		}
+		entries = iinf[8:]
+	}
+
+	for len(entries) >= 8 {
+		size := binary.BigEndian.Uint32(entries)
+		if size < 8 || uint64(size) > uint64(len(entries)) {
+			break
+		}
+		boxType, infe := string(entries[4:8]), entries[8:size]
+		entries = entries[size:]
+		// 버전 2 이상의 infe만 항목 종류를 기록합니다
+		if boxType != "infe" || len(infe) < 4 || infe[0] < 2 {
+			continue
+		}
+
+		version := infe[0]
+		body := infe[4:]
+		var id uint32
+		if version == 2 {
+			if len(body) < 8 {
+				continue
+			}
+			id = uint32(binary.BigEndian.Uint16(body))
+			body = body[4:]
+		} else {
+			if len(body) < 10 {
+				continue
+			}
+			id = binary.BigEndian.Uint32(body)
+			body = body[6:]
+		}
+
+		switch string(body[:4]) {
+		case "Exif":
+			exifItems[id] = true
+		case "mime":
+			// item_name 다음에 content_type이 NUL로 끝나는 문자열로 이어집니다
+			_, rest, ok := bytes.Cut(body[4:], []byte{0})
+			if ok && bytes.HasPrefix(rest, []byte("application/rdf+xml")) {
+				xmpItems[id] = true
+			}
+		}
+	}
+	return exifItems, xmpItems
+}
+
+// heifItemLocations는 iloc 상자에서 파일 위치로 저장된(construction_method 0) 한 조각짜리 항목의 위치를 읽습니다
+func heifItemLocations(iloc []byte) map[uint32]heifItem {
+	locations := map[uint32]heifItem{}
+	if len(iloc) < 8 {
+		return locations
+	}
+	version := iloc[0]
+	offsetSize := int(iloc[4] >> 4)
+	lengthSize := int(iloc[4] & 0x0F)
+	baseOffsetSize := int(iloc[5] >> 4)
+	indexSize := 0
+	if version == 1 || version == 2 {
+		indexSize = int(iloc[5] & 0x0F)
+	}
+
+	r := &boxReader{data: iloc[6:], ok: true}
+	var count uint64
+	if version < 2 {
+		count = r.uint(2)
+	} else {
+		count = r.uint(4)
+	}
+
+	for n := uint64(0); n < count && r.ok; n++ {
+		var id uint64
+		if version < 2 {
+			id = r.uint(2)
+		} else {
+			id = r.uint(4)
+		}
+		method := uint64(0)
+		if version == 1 || version == 2 {
+			method = r.uint(2) & 0x0F
+		}
+		r.uint(2) // data_reference_index
+		baseOffset := r.uint(baseOffsetSize)
+		extents := r.uint(2)
+
+		var item heifItem
+		for e := uint64(0); e < extents && r.ok; e++ {
+			r.uint(indexSize)
+			item = heifItem{offset: baseOffset + r.uint(offsetSize), length: r.uint(lengthSize)}
+		}
+		if r.ok && method == 0 && extents == 1 && item.length > 0 {
+			locations[uint32(id)] = item
+		}
+	}
+	return locations
+}
+
+// boxReader는 상자 안의 가변 길이 정수를 차례로 읽습니다. 범위를 벗어나면 ok가 false가 됩니다.
+type boxReader struct {
+	data []byte
+	pos  int
+	ok   bool
+}
+
+func (r *boxReader) uint(size int) uint64 {
+	if !r.ok || r.pos+size > len(r.data) {
+		r.ok = false
+		return 0
+	}
+	var v uint64
+	for _, b := range r.data[r.pos : r.pos+size] {
+		v = v<<8 | uint64(b)
+	}
+	r.pos += size
+	return v
+}
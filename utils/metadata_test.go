@@ -0,0 +1,232 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"math"
+	"testing"
+)
+
+// gpsLatitude는 테스트 EXIF에 기록하는 위도 값입니다
+var gpsLatitude = []byte{0, 0, 0, 37, 0, 0, 0, 1, 0, 0, 0, 33, 0, 0, 0, 1, 0, 0, 0, 59, 0, 0, 0, 1}
+
+// testTIFF는 회전 정보와 GPS 위도가 담긴 빅 엔디언 TIFF입니다
+func testTIFF() []byte {
+	var b bytes.Buffer
+	write := func(v any) { _ = binary.Write(&b, binary.BigEndian, v) }
+
+	b.WriteString("MM")
+	write(uint16(42))
+	write(uint32(8))
+
+	// IFD0: 회전 정보, GPS IFD 위치
+	write(uint16(2))
+	write([]uint16{exifOrientationTag, 3})
+	write(uint32(1))
+	write([]uint16{6, 0})
+	write([]uint16{exifGPSInfoTag, 4})
+	write(uint32(1))
+	write(uint32(38))
+	write(uint32(0))
+
+	// GPS IFD: 위도(RATIONAL 3개)
+	write(uint16(1))
+	write([]uint16{0x0002, 5})
+	write(uint32(3))
+	write(uint32(56))
+	write(uint32(0))
+	b.Write(gpsLatitude)
+	return b.Bytes()
+}
+
+func testImage() image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for i := range img.Pix {
+		img.Pix[i] = 0xFF
+	}
+	img.Set(1, 1, color.RGBA{R: 200, A: 255})
+	return img
+}
+
+func testJPEG(t *testing.T) []byte {
+	var encoded bytes.Buffer
+	if err := jpeg.Encode(&encoded, testImage(), nil); err != nil {
+		t.Fatal(err)
+	}
+	segment := func(payload []byte) []byte {
+		out := []byte{0xFF, 0xE1, 0, 0}
+		binary.BigEndian.PutUint16(out[2:], uint16(len(payload)+2))
+		return append(out, payload...)
+	}
+
+	data := append([]byte{}, encoded.Bytes()[:2]...)
+	data = append(data, segment(append([]byte("Exif\x00\x00"), testTIFF()...))...)
+	data = append(data, segment(append(append([]byte{}, xmpNamespace...), "<x:xmpmeta/>"...))...)
+	return append(data, encoded.Bytes()[2:]...)
+}
+
+func pngChunk(chunkType string, body []byte) []byte {
+	out := make([]byte, 8, 12+len(body))
+	binary.BigEndian.PutUint32(out, uint32(len(body)))
+	copy(out[4:], chunkType)
+	out = append(out, body...)
+	return binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(out[4:]))
+}
+
+func testPNG(t *testing.T) []byte {
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, testImage()); err != nil {
+		t.Fatal(err)
+	}
+	// 시그니처와 IHDR 청크 뒤에 메타데이터를 넣습니다
+	ihdrEnd := len(pngSignature) + 25
+	data := append([]byte{}, encoded.Bytes()[:ihdrEnd]...)
+	data = append(data, pngChunk("eXIf", testTIFF())...)
+	data = append(data, pngChunk("iTXt", []byte("XML:com.adobe.xmp\x00\x00\x00\x00\x00<x:xmpmeta/>"))...)
+	return append(data, encoded.Bytes()[ihdrEnd:]...)
+}
+
+func webpChunk(chunkType string, body []byte) []byte {
+	out := make([]byte, 8, 9+len(body))
+	copy(out, chunkType)
+	binary.LittleEndian.PutUint32(out[4:], uint32(len(body)))
+	out = append(out, body...)
+	if len(body)%2 == 1 {
+		out = append(out, 0)
+	}
+	return out
+}
+
+func testWebP() []byte {
+	// 4x4 크기에 EXIF와 XMP가 있음을 표시한 VP8X입니다
+	vp8x := []byte{0x0C, 0, 0, 0, 3, 0, 0, 3, 0, 0}
+	body := []byte("WEBP")
+	body = append(body, webpChunk("VP8X", vp8x)...)
+	body = append(body, webpChunk("EXIF", testTIFF())...)
+	body = append(body, webpChunk("XMP ", []byte("<x:xmpmeta/>"))...)
+
+	data := []byte("RIFF\x00\x00\x00\x00")
+	binary.LittleEndian.PutUint32(data[4:], uint32(len(body)))
+	return append(data, body...)
+}
+
+func isoBox(boxType string, body ...[]byte) []byte {
+	content := bytes.Join(body, nil)
+	out := make([]byte, 8, 8+len(content))
+	binary.BigEndian.PutUint32(out, uint32(8+len(content)))
+	copy(out[4:], boxType)
+	return append(out, content...)
+}
+
+func testHEIF() []byte {
+	exif := append([]byte{0, 0, 0, 0}, testTIFF()...)
+	xmp := []byte("<x:xmpmeta/>")
+
+	infe := func(id uint16, itemType string, extra string) []byte {
+		body := []byte{2, 0, 0, 0, 0, byte(id), 0, 0}
+		body = append(body, itemType...)
+		return isoBox("infe", append(body, "\x00"+extra...))
+	}
+	iinf := isoBox("iinf", []byte{0, 0, 0, 0, 0, 2}, infe(1, "Exif", ""), infe(2, "mime", "application/rdf+xml\x00"))
+
+	ftyp := isoBox("ftyp", []byte("heic\x00\x00\x00\x00mif1heic"))
+	iloc := func(exifOffset, xmpOffset uint32) []byte {
+		body := []byte{0, 0, 0, 0, 0x44, 0x00, 0, 2}
+		for _, item := range []struct {
+			id             uint16
+			offset, length uint32
+		}{{1, exifOffset, uint32(len(exif))}, {2, xmpOffset, uint32(len(xmp))}} {
+			body = binary.BigEndian.AppendUint16(body, item.id)
+			body = append(body, 0, 0, 0, 1)
+			body = binary.BigEndian.AppendUint32(body, item.offset)
+			body = binary.BigEndian.AppendUint32(body, item.length)
+		}
+		return isoBox("iloc", body)
+	}
+
+	// iloc의 길이는 위치 값과 무관하므로 먼저 계산한 뒤 실제 위치로 다시 만듭니다
+	meta := isoBox("meta", []byte{0, 0, 0, 0}, iinf, iloc(0, 0))
+	mdatStart := uint32(len(ftyp) + len(meta) + 8)
+	meta = isoBox("meta", []byte{0, 0, 0, 0}, iinf, iloc(mdatStart, mdatStart+uint32(len(exif))))
+	return bytes.Join([][]byte{ftyp, meta, isoBox("mdat", exif, xmp)}, nil)
+}
+
+// overflowHEIF는 Exif 항목의 위치와 길이를 더하면 uint64 범위를 넘는 HEIF입니다
+func overflowHEIF() []byte {
+	infe := isoBox("infe", []byte{2, 0, 0, 0, 0, 1, 0, 0}, []byte("Exif\x00"))
+	iinf := isoBox("iinf", []byte{0, 0, 0, 0, 0, 1}, infe)
+
+	iloc := []byte{0, 0, 0, 0, 0x88, 0x00, 0, 1, 0, 1, 0, 0, 0, 1}
+	iloc = binary.BigEndian.AppendUint64(iloc, math.MaxUint64-3)
+	iloc = binary.BigEndian.AppendUint64(iloc, 8)
+
+	meta := isoBox("meta", []byte{0, 0, 0, 0}, iinf, isoBox("iloc", iloc))
+	return append(isoBox("ftyp", []byte("heic\x00\x00\x00\x00mif1heic")), meta...)
+}
+
+func TestStripLocation(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		data        []byte
+		decodes     bool
+	}{
+		{name: "JPEG", contentType: "image/jpeg", data: testJPEG(t), decodes: true},
+		{name: "PNG", contentType: "image/png", data: testPNG(t), decodes: true},
+		{name: "WebP", contentType: "image/webp", data: testWebP()},
+		{name: "HEIF", contentType: "image/heic", data: testHEIF()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stripped, changed := StripLocation(tt.data, tt.contentType)
+			if !changed {
+				t.Fatal("위치 정보를 지우지 않았습니다")
+			}
+			if bytes.Contains(stripped, gpsLatitude) {
+				t.Error("GPS 값이 남아 있습니다")
+			}
+			if bytes.Contains(stripped, []byte("xmpmeta")) {
+				t.Error("XMP가 남아 있습니다")
+			}
+			if !bytes.Contains(stripped, []byte{0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, 0x06}) {
+				t.Error("회전 정보가 지워졌습니다")
+			}
+			if tt.decodes {
+				if _, _, err := image.Decode(bytes.NewReader(stripped)); err != nil {
+					t.Errorf("이미지를 읽을 수 없습니다: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestStripLocationKeepsUnknownData(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		data        []byte
+	}{
+		{name: "빈 파일", contentType: "image/jpeg", data: nil},
+		{name: "형식과 다른 내용", contentType: "image/png", data: []byte("not a png")},
+		{name: "잘린 JPEG", contentType: "image/jpeg", data: []byte{0xFF, 0xD8, 0xFF, 0xE1, 0xFF}},
+		{name: "잘린 HEIF", contentType: "image/heif", data: isoBox("meta", []byte{0, 0, 0, 0, 0, 0, 0, 99})},
+		{name: "위치와 길이의 합이 넘치는 HEIF", contentType: "image/heic", data: overflowHEIF()},
+		{name: "지원하지 않는 형식", contentType: "image/gif", data: []byte("GIF89a")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := append([]byte{}, tt.data...)
+			stripped, changed := StripLocation(tt.data, tt.contentType)
+			if changed || !bytes.Equal(stripped, original) {
+				t.Errorf("바뀌지 않아야 합니다: changed=%v", changed)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package utils
+
+import (
+	"image"
+	"image/color"
+	"sort"
+)
+
+const (
+	// paletteSampleWidth는 색상 분석 전에 이미지를 줄이는 가로 크기입니다
+	paletteSampleWidth = 64
+	// paletteMinDistance보다 가까운 색은 같은 색으로 묶습니다 (RGB 거리의 제곱)
+	paletteMinDistance = 48 * 48
+)
+
+// PaletteColor는 이미지에서 뽑은 색과 그 색이 차지하는 비율(0~1)입니다
+type PaletteColor struct {
+	Color  color.NRGBA
+	Weight float64
+}
+
+type colorBucket struct {
+	r, g, b, count int
+}
+
+func (b colorBucket) color() color.NRGBA {
+	return color.NRGBA{
+		R: uint8(b.r / b.count),
+		G: uint8(b.g / b.count),
+		B: uint8(b.b / b.count),
+		A: 255,
+	}
+}
+
+func colorDistance(a, b color.NRGBA) int {
+	dr, dg, db := int(a.R)-int(b.R), int(a.G)-int(b.G), int(a.B)-int(b.B)
+	return dr*dr + dg*dg + db*db
+}
+
+// ExtractPalette는 이미지에서 많이 쓰인 색을 비율이 큰 순서로 최대 n개 뽑습니다.
+// 채널마다 16단계로 나눈 칸에 픽셀을 모은 뒤, 서로 비슷한 칸은 더 많이 쓰인 색에 합칩니다.
+// 대부분 투명한 픽셀은 건너뜁니다.
+func ExtractPalette(img image.Image, n int) []PaletteColor {
+	img = ResizeToWidth(img, paletteSampleWidth)
+	bounds := img.Bounds()
+
+	buckets := make(map[int]*colorBucket)
+	total := 0
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if c.A < 128 {
+				continue
+			}
+			index := int(c.R>>4)<<8 | int(c.G>>4)<<4 | int(c.B>>4)
+			bucket, ok := buckets[index]
+			if !ok {
+				bucket = &colorBucket{}
+				buckets[index] = bucket
+			}
+			bucket.r += int(c.R)
+			bucket.g += int(c.G)
+			bucket.b += int(c.B)
+			bucket.count++
+			total++
+		}
+	}
+	if total == 0 {
+		return []PaletteColor{}
+	}
+
+	sorted := make([]*colorBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		sorted = append(sorted, bucket)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].count != sorted[j].count {
+			return sorted[i].count > sorted[j].count
+		}
+		// 같은 비율이면 밝은 색부터 놓아 결과가 항상 같도록 합니다
+		return sorted[i].r+sorted[i].g+sorted[i].b > sorted[j].r+sorted[j].g+sorted[j].b
+	})
+
+	merged := make([]*colorBucket, 0, n)
+	for _, bucket := range sorted {
+		c := bucket.color()
+		var nearest *colorBucket
+		for _, m := range merged {
+			if colorDistance(m.color(), c) < paletteMinDistance {
+				nearest = m
+				break
+			}
+		}
+		if nearest != nil {
+			nearest.r += bucket.r
+			nearest.g += bucket.g
+			nearest.b += bucket.b
+			nearest.count += bucket.count
+			continue
+		}
+		merged = append(merged, &colorBucket{r: bucket.r, g: bucket.g, b: bucket.b, count: bucket.count})
+	}
+
+	// 합쳐진 뒤의 비율로 다시 정렬합니다
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].count > merged[j].count
+	})
+	if len(merged) > n {
+		merged = merged[:n]
+	}
+
+	palette := make([]PaletteColor, len(merged))
+	for i, bucket := range merged {
+		palette[i] = PaletteColor{
+			Color:  bucket.color(),
+			Weight: float64(bucket.count) / float64(total),
+		}
+	}
+	return palette
+}
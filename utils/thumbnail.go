@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"image"
+
+	"golang.org/x/image/draw"
+)
+
+// ApplyOrientation은 EXIF 회전 정보(1~8)에 맞게 이미지를 바로 세웁니다
+func ApplyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	src := img.Bounds()
+	w, h := src.Dx(), src.Dy()
+	// 5~8은 가로·세로가 뒤바뀐 이미지입니다
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			}
+			dst.Set(dx, dy, img.At(src.Min.X+x, src.Min.Y+y))
+		}
+	}
+	return dst
+}
+
+// ResizeToWidth는 비율을 유지한 채 가로 크기를 width로 줄입니다. 원본이 더 작으면 확대하지 않습니다.
+func ResizeToWidth(img image.Image, width int) image.Image {
+	src := img.Bounds()
+	if src.Dx() <= width {
+		return img
+	}
+
+	height := src.Dy() * width / src.Dx()
+	if height < 1 {
+		height = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, src, draw.Src, nil)
+	return dst
+}
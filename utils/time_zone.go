@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"errors"
+	"time"
+)
+
+// DefaultTimeZone은 시간대를 설정하지 않은 사용자에게 적용됩니다
+const DefaultTimeZone = "Asia/Seoul"
+
+var errNotIANATimeZone = errors.New("IANA 시간대 이름이 아닙니다")
+
+// LoadTimeZone은 IANA 시간대 이름으로 위치 정보를 불러옵니다. 빈 값이면 DefaultTimeZone을 사용합니다.
+func LoadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		name = DefaultTimeZone
+	}
+	return LoadIANATimeZone(name)
+}
+
+// LoadIANATimeZone은 Asia/Seoul과 같은 IANA 시간대 이름만 불러옵니다.
+// time.LoadLocation이 허용하는 빈 값(UTC)과 Local(서버 시간대)은 사용자마다 뜻이 달라지므로 거부합니다.
+func LoadIANATimeZone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, errNotIANATimeZone
+	}
+	return time.LoadLocation(name)
+}
+
+// StoredLocation은 저장된 일정·티켓의 시간대를 불러옵니다.
+// 시간대 없이 저장된 기존 데이터는 UTC에 현지 시각을 그대로 기록했으므로 UTC로 해석합니다.
+func StoredLocation(name string) *time.Location {
+	if name == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}